@@ -0,0 +1,80 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func convertAliases(aliases []*pb.LabelAlias) map[int32]*pb.LabelAlias {
+	m := make(map[int32]*pb.LabelAlias)
+	for _, alias := range aliases {
+		m[alias.GetLabelId()] = alias
+	}
+	return m
+}
+
+// labelRules holds the org's label aliases and overrides; a nil set of rules changes nothing
+type labelRules struct {
+	aliases   map[int32]*pb.LabelAlias
+	overrides map[int64]*pb.LabelOverride
+}
+
+func newLabelRules(org *pb.Organisation) *labelRules {
+	return &labelRules{aliases: convertAliases(org.GetLabelAliases()), overrides: convertOverrides(org.GetLabelOverrides())}
+}
+
+// canonical is the name we use for the label
+func (r *labelRules) canonical(label *pbgd.Label) string {
+	if r != nil {
+		if name := r.aliases[label.GetId()].GetCanonicalName(); name != "" {
+			return name
+		}
+	}
+	return label.GetName()
+}
+
+// family is the family the label is shelved with, the label itself if it has none
+func (r *labelRules) family(label *pbgd.Label) string {
+	if r != nil {
+		if family := r.aliases[label.GetId()].GetFamily(); family != "" {
+			return family
+		}
+	}
+	return r.canonical(label)
+}
+
+// UpdateLabelAlias adds, replaces or removes the alias for a label
+func (s *Server) UpdateLabelAlias(ctx context.Context, req *pb.UpdateLabelAliasRequest) (*pb.UpdateLabelAliasResponse, error) {
+	if req.GetAlias().GetLabelId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Aliases need a label id")
+	}
+	if !req.GetDelete() && strings.TrimSpace(req.GetAlias().GetCanonicalName()) == "" && strings.TrimSpace(req.GetAlias().GetFamily()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Aliases need a canonical name or a family")
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases []*pb.LabelAlias
+	for _, alias := range org.GetLabelAliases() {
+		if alias.GetLabelId() != req.GetAlias().GetLabelId() {
+			aliases = append(aliases, alias)
+		}
+	}
+	if !req.GetDelete() {
+		aliases = append(aliases, req.GetAlias())
+	}
+	sort.SliceStable(aliases, func(i, j int) bool { return aliases[i].GetLabelId() < aliases[j].GetLabelId() })
+	org.LabelAliases = aliases
+
+	return &pb.UpdateLabelAliasResponse{Aliases: org.GetLabelAliases()}, s.saveOrg(ctx, org)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func aliasRecord(iid int64, id int32, name, catno string) *pbrc.Record {
+	return &pbrc.Record{Release: &pbd.Release{InstanceId: iid, Labels: []*pbd.Label{{Id: id, Name: name, Catno: catno}}}}
+}
+
+func TestAliasesGroupLabels(t *testing.T) {
+	rules := &labelRules{aliases: convertAliases([]*pb.LabelAlias{
+		{LabelId: 2, CanonicalName: "Warp"},
+		{LabelId: 3, Family: "Warp"},
+	})}
+	records := []*pbrc.Record{
+		aliasRecord(1, 1, "Warp", "WARP 3"),
+		aliasRecord(2, 4, "Virgin", "V 1"),
+		aliasRecord(3, 2, "Warp Records", "WARP 2"),
+		aliasRecord(4, 3, "Lex", "LEX 1"),
+		aliasRecord(5, 5, "Xtra Mile", "XM 1"),
+	}
+
+	sortByLabel(records, nil, rules)
+	var order []int64
+	for _, rec := range records {
+		order = append(order, rec.GetRelease().GetInstanceId())
+	}
+	// Lex is shelved in the Warp family, ahead of Warp itself
+	want := []int64{2, 4, 3, 1, 5}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("Bad order: %v, want %v", order, want)
+		}
+	}
+
+	cache := &pb.SortingCache{}
+	for _, rec := range records {
+		if entry := appendCache(cache, rec, nil, rules); rec.GetRelease().GetInstanceId() == 3 && entry.GetMainLabel() != "Warp" {
+			t.Errorf("Cache did not use the alias: %v", entry)
+		}
+	}
+	if d := labelDivergences(records, cache, nil, rules); len(d) > 0 {
+		t.Errorf("Cached sort ignored aliases: %v", d)
+	}
+}
+
+func TestLabelMatchUsesAliases(t *testing.T) {
+	s := getTestServer(".aliasMatch")
+	r1 := aliasRecord(1, 1, "Warp", "WARP 1")
+	r2 := aliasRecord(2, 2, "Warp Records", "WARP 1")
+
+	if s.labelMatch(context.Background(), r1, r2, &pb.SortingCache{}, nil) {
+		t.Errorf("Labels matched without an alias")
+	}
+	if !s.labelMatch(context.Background(), r1, r2, &pb.SortingCache{}, &labelRules{aliases: convertAliases([]*pb.LabelAlias{{LabelId: 2, CanonicalName: "Warp"}})}) {
+		t.Errorf("Labels did not match with an alias")
+	}
+}
+
+func TestUpdateLabelAlias(t *testing.T) {
+	s := getTestServer(".updateAlias")
+	ctx := context.Background()
+
+	if _, err := s.UpdateLabelAlias(ctx, &pb.UpdateLabelAliasRequest{Alias: &pb.LabelAlias{LabelId: 2}}); err == nil {
+		t.Errorf("Empty alias was accepted")
+	}
+
+	s.UpdateLabelAlias(ctx, &pb.UpdateLabelAliasRequest{Alias: &pb.LabelAlias{LabelId: 2, CanonicalName: "Warp"}})
+	resp, err := s.UpdateLabelAlias(ctx, &pb.UpdateLabelAliasRequest{Alias: &pb.LabelAlias{LabelId: 2, CanonicalName: "Warp", Family: "Warp"}})
+	if err != nil || len(resp.GetAliases()) != 1 || resp.GetAliases()[0].GetFamily() != "Warp" {
+		t.Fatalf("Bad update: %v, %v", resp, err)
+	}
+
+	resp, err = s.UpdateLabelAlias(ctx, &pb.UpdateLabelAliasRequest{Alias: &pb.LabelAlias{LabelId: 2}, Delete: true})
+	if err != nil || len(resp.GetAliases()) != 0 {
+		t.Errorf("Bad delete: %v, %v", resp, err)
+	}
+}
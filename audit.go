@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+const (
+	//AUDIT_KEY is where we store the audit log
+	AUDIT_KEY = "github.com/brotherlogic/recordsorganiser/audit"
+
+	// We keep a year of audit entries, and no more than this many of them
+	auditRetention  = time.Hour * 24 * 365
+	maxAuditEntries = 5000
+)
+
+// auditLock serialises the read-modify-write of the audit log
+var auditLock sync.Mutex
+
+func (s *Server) readAudit(ctx context.Context) (*pb.AuditLog, error) {
+	audit := &pb.AuditLog{}
+	data, _, err := s.KSclient.Read(ctx, AUDIT_KEY, audit)
+	if err != nil {
+		if status.Convert(err).Code() == codes.InvalidArgument {
+			return audit, nil
+		}
+		return nil, err
+	}
+	return data.(*pb.AuditLog), nil
+}
+
+func (s *Server) saveAudit(ctx context.Context, audit *pb.AuditLog) error {
+	pruneAudit(audit, time.Now())
+	return s.KSclient.Save(ctx, AUDIT_KEY, audit)
+}
+
+// pruneAudit ages out old entries and caps the size of the log, keeping the newest
+func pruneAudit(audit *pb.AuditLog, now time.Time) {
+	var entries []*pb.AuditEntry
+	for _, entry := range audit.GetEntries() {
+		if entry.GetTimestamp() >= now.Add(-auditRetention).Unix() {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) > maxAuditEntries {
+		entries = entries[len(entries)-maxAuditEntries:]
+	}
+	audit.Entries = entries
+}
+
+// quotaState summarises how full a location is against its quota
+func quotaState(c *pb.Location) string {
+	switch {
+	case c.GetQuota().GetSlots() > 0:
+		mslot := int32(0)
+		for _, elem := range c.GetReleasesLocation() {
+			if elem.GetSlot() > mslot {
+				mslot = elem.GetSlot()
+			}
+		}
+		return fmt.Sprintf("slots %v/%v", mslot, c.GetQuota().GetSlots())
+	case c.GetQuota().GetAbsoluteWidth() > 0:
+		twidth := float32(0)
+		for _, elem := range c.GetReleasesLocation() {
+			twidth += elem.GetDeterminedWidth()
+		}
+		return fmt.Sprintf("width %.1f/%.1f", twidth, c.GetQuota().GetAbsoluteWidth())
+	case c.GetQuota().GetTotalWidth() > 0:
+		return fmt.Sprintf("slot width %.1f", c.GetQuota().GetTotalWidth())
+	case c.GetQuota().GetNumOfSlots() > 0:
+		return fmt.Sprintf("records %v/%v", len(c.GetReleasesLocation()), c.GetQuota().GetNumOfSlots())
+	}
+	return "no quota"
+}
+
+// updateRecord sends the update to recordcollection and records it in the audit log
+func (s *Server) updateRecord(ctx context.Context, c *pb.Location, action string, req *pbrc.UpdateRecordRequest) (*pbrc.UpdateRecordsResponse, error) {
+	resp, err := s.bridge.updateRecord(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	audit, err := s.readAudit(ctx)
+	if err == nil {
+		audit.Entries = append(audit.Entries, &pb.AuditEntry{
+			InstanceId: req.GetUpdate().GetRelease().GetInstanceId(),
+			Action:     action,
+			Reason:     req.GetReason(),
+			Location:   c.GetName(),
+			QuotaState: quotaState(c),
+			Timestamp:  time.Now().Unix(),
+		})
+		err = s.saveAudit(ctx, audit)
+	}
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to audit update to %v: %v", req.GetUpdate().GetRelease().GetInstanceId(), err))
+	}
+
+	return resp, nil
+}
+
+// QueryAudit returns the audit entries matching the request
+func (s *Server) QueryAudit(ctx context.Context, req *pb.QueryAuditRequest) (*pb.QueryAuditResponse, error) {
+	audit, err := s.readAudit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*pb.AuditEntry
+	for _, entry := range audit.GetEntries() {
+		if (req.GetLocation() == "" || entry.GetLocation() == req.GetLocation()) &&
+			(req.GetInstanceId() == 0 || entry.GetInstanceId() == req.GetInstanceId()) &&
+			(req.GetStartTime() == 0 || entry.GetTimestamp() >= req.GetStartTime()) &&
+			(req.GetEndTime() == 0 || entry.GetTimestamp() <= req.GetEndTime()) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return &pb.QueryAuditResponse{Entries: entries}, nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestAuditUpdates(t *testing.T) {
+	s := getTestServer(".auditUpdates")
+	loc := &pb.Location{
+		Name:             "testing",
+		Quota:            &pb.Quota{QuotaType: &pb.Quota_Slots{Slots: 1}},
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 2}},
+	}
+
+	for _, iid := range []int64{1, 2} {
+		_, err := s.updateRecord(context.Background(), loc, "prepare-to-sell", &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: iid}}})
+		if err != nil {
+			t.Fatalf("Unable to update record: %v", err)
+		}
+	}
+
+	resp, err := s.QueryAudit(context.Background(), &pb.QueryAuditRequest{Location: "testing"})
+	if err != nil {
+		t.Fatalf("Unable to query audit: %v", err)
+	}
+	if len(resp.GetEntries()) != 2 || resp.GetEntries()[0].GetQuotaState() != "slots 2/1" {
+		t.Errorf("Bad audit log: %v", resp)
+	}
+
+	resp, err = s.QueryAudit(context.Background(), &pb.QueryAuditRequest{InstanceId: 2})
+	if err != nil || len(resp.GetEntries()) != 1 {
+		t.Errorf("Bad audit query for record: %v, %v", resp, err)
+	}
+
+	resp, err = s.QueryAudit(context.Background(), &pb.QueryAuditRequest{StartTime: time.Now().Add(time.Hour).Unix()})
+	if err != nil || len(resp.GetEntries()) != 0 {
+		t.Errorf("Bad audit query for time: %v, %v", resp, err)
+	}
+}
+
+func TestPruneAudit(t *testing.T) {
+	now := time.Now()
+	audit := &pb.AuditLog{Entries: []*pb.AuditEntry{{InstanceId: 1, Timestamp: now.Add(-auditRetention - time.Hour).Unix()}}}
+	for i := 0; i < maxAuditEntries+10; i++ {
+		audit.Entries = append(audit.Entries, &pb.AuditEntry{InstanceId: int64(i + 2), Timestamp: now.Unix()})
+	}
+
+	pruneAudit(audit, now)
+	if len(audit.GetEntries()) != maxAuditEntries {
+		t.Fatalf("Audit was not capped: %v entries", len(audit.GetEntries()))
+	}
+	if audit.GetEntries()[0].GetInstanceId() != 12 || audit.GetEntries()[maxAuditEntries-1].GetInstanceId() != maxAuditEntries+11 {
+		t.Errorf("Pruning kept the wrong entries: %v ... %v", audit.GetEntries()[0], audit.GetEntries()[maxAuditEntries-1])
+	}
+}
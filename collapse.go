@@ -10,10 +10,10 @@ import (
 	ropb "github.com/brotherlogic/recordsorganiser/proto"
 )
 
-func (s *Server) labelMatch(ctx context.Context, r1, r2 *rcpb.Record, cache *ropb.SortingCache) bool {
+func (s *Server) labelMatch(ctx context.Context, r1, r2 *rcpb.Record, cache *ropb.SortingCache, rules *labelRules) bool {
 	for _, label1 := range r1.GetRelease().GetLabels() {
 		for _, label2 := range r2.GetRelease().GetLabels() {
-			if label1.GetName() == label2.GetName() {
+			if rules.canonical(label1) == rules.canonical(label2) {
 				if getEntry(cache, r1.GetRelease().GetInstanceId()).GetMainLabel() != getEntry(cache, r2.GetRelease().GetInstanceId()).GetMainLabel() &&
 					getEntry(cache, r1.GetRelease().GetInstanceId()).GetMainLabel() != "" {
 					s.CtxLog(ctx, fmt.Sprintf("Raising because %v and %v", r1, r2))
@@ -26,40 +26,17 @@ func (s *Server) labelMatch(ctx context.Context, r1, r2 *rcpb.Record, cache *rop
 	return false
 }
 
-func (s *Server) adjust(width float32, mSleeve, dSleeve rcpb.ReleaseMetadata_SleeveState) float32 {
+// adjust converts a width measured in one sleeve to the width in another
+func (s *Server) adjust(width float32, mSleeve, dSleeve rcpb.ReleaseMetadata_SleeveState, factors map[int32]float32) float32 {
 	if mSleeve == dSleeve {
 		return width
 	}
 
-	if mSleeve == rcpb.ReleaseMetadata_BOX_SET && dSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP {
-		return width * 1.26
-	}
-	if mSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP && dSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_NO_INNER {
-		return width * (1.4 / 1.26)
-	}
-	if mSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP && dSleeve == rcpb.ReleaseMetadata_BOX_SET {
-		return width * (1 / 1.26)
-	}
-	if mSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_NO_INNER && dSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP {
-		return width * (1.26 / 1.4)
-	}
-	if mSleeve == rcpb.ReleaseMetadata_SLEEVE_UNKNOWN && dSleeve == rcpb.ReleaseMetadata_BOX_SET {
-		return width * (1 / 1.18)
-	}
-	if mSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP && dSleeve == rcpb.ReleaseMetadata_SLEEVE_UNKNOWN {
-		return width * (1.18 / 1.26)
-	}
-	if mSleeve == rcpb.ReleaseMetadata_SLEEVE_UNKNOWN && dSleeve == rcpb.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP {
-		return width * (1.26 / 1.18)
-	}
-
-	s.RaiseIssue("Sleeve mismatch", fmt.Sprintf("%v -> %v", mSleeve, dSleeve))
-
-	return width
+	return width * (sleeveFactor(factors, dSleeve) / sleeveFactor(factors, mSleeve))
 }
 
 // For now this just collapses similar records down to a simple map
-func (s *Server) collapse(ctx context.Context, records []*rcpb.Record, cache *ropb.SortingCache) ([]*rcpb.Record, map[int64][]*rcpb.Record) {
+func (s *Server) collapse(ctx context.Context, records []*rcpb.Record, cache *ropb.SortingCache, factors map[int32]float32, rules *labelRules) ([]*rcpb.Record, map[int64][]*rcpb.Record) {
 	mapper := make(map[int64][]*rcpb.Record)
 	var nrecords []*rcpb.Record
 	var trecord *rcpb.Record
@@ -67,9 +44,9 @@ func (s *Server) collapse(ctx context.Context, records []*rcpb.Record, cache *ro
 
 	for i, rec := range records {
 		if inlabel {
-			if s.labelMatch(ctx, trecord, rec, cache) {
+			if s.labelMatch(ctx, trecord, rec, cache, rules) {
 				mapper[trecord.GetRelease().GetInstanceId()] = append(mapper[trecord.GetRelease().GetInstanceId()], rec)
-				trecord.GetMetadata().RecordWidth += s.adjust(rec.GetMetadata().GetRecordWidth(), trecord.GetMetadata().GetSleeve(), rec.GetMetadata().GetSleeve())
+				trecord.GetMetadata().RecordWidth += s.adjust(rec.GetMetadata().GetRecordWidth(), trecord.GetMetadata().GetSleeve(), rec.GetMetadata().GetSleeve(), factors)
 			} else {
 				nrecords = append(nrecords, trecord)
 				trecord = nil
@@ -79,7 +56,7 @@ func (s *Server) collapse(ctx context.Context, records []*rcpb.Record, cache *ro
 
 		if !inlabel {
 			if i < len(records)-1 {
-				if s.labelMatch(ctx, rec, records[i+1], cache) {
+				if s.labelMatch(ctx, rec, records[i+1], cache, rules) {
 					inlabel = true
 					temp := proto.Clone(rec)
 					trecord = temp.(*rcpb.Record)
@@ -51,10 +51,10 @@ func TestCompareCollapse(t *testing.T) {
 			t.Fatalf("Unable to load records")
 		}
 		cache := &pb.SortingCache{}
-		appendCache(cache, r1)
-		appendCache(cache, r2)
+		appendCache(cache, r1, nil, nil)
+		appendCache(cache, r2, nil, nil)
 
-		server.labelMatch(context.Background(), r1, r2, cache)
+		server.labelMatch(context.Background(), r1, r2, cache, nil)
 
 		if server.IssueCount > 0 {
 			t.Fatalf("Issue raised in label comparison: %v, %v", server.IssueCount, server.SkipIssue)
@@ -92,7 +92,7 @@ func TestBasicFunc(t *testing.T) {
 		}},
 	}
 
-	nrecs, mapper := s.collapse(context.Background(), records, &pb.SortingCache{})
+	nrecs, mapper := s.collapse(context.Background(), records, &pb.SortingCache{}, defaultSleeveFactors(), nil)
 
 	if len(nrecs) != 2 {
 		t.Errorf("Should be two records here: %v", nrecs)
@@ -146,7 +146,7 @@ func TestReverseFunc(t *testing.T) {
 		}},
 	}
 
-	nrecs, mapper := s.collapse(context.Background(), records, &pb.SortingCache{})
+	nrecs, mapper := s.collapse(context.Background(), records, &pb.SortingCache{}, defaultSleeveFactors(), nil)
 
 	if len(nrecs) != 3 {
 		t.Errorf("Should be two records here: %v", nrecs)
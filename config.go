@@ -0,0 +1,196 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// runtimeFields are the parts of a location maintained by the organiser rather than by configuration
+var runtimeFields = map[protoreflect.Name]bool{
+	"releases_location": true,
+	"timestamp":         true,
+	"last_reorg":        true,
+	"over_quota_time":   true,
+	"slots_to_sort":     true,
+	"last_sort":         true,
+}
+
+// configureLocation builds the new location from the config, keeping the runtime state of the current one
+func configureLocation(current, config *pb.Location) *pb.Location {
+	loc := proto.Clone(config).(*pb.Location)
+	src := current.ProtoReflect()
+	dst := loc.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if runtimeFields[field.Name()] {
+			dst.Clear(field)
+			if current != nil && src.Has(field) {
+				dst.Set(field, src.Get(field))
+			}
+		}
+	}
+	return loc
+}
+
+// fieldString prints a single field of a message
+func fieldString(m protoreflect.Message, field protoreflect.FieldDescriptor) string {
+	if !m.Has(field) {
+		return "<unset>"
+	}
+	single := m.New()
+	single.Set(field, m.Get(field))
+	val := strings.TrimSpace(prototext.MarshalOptions{}.Format(single.Interface()))
+	return strings.TrimSpace(strings.ReplaceAll(val, string(field.Name())+":", ""))
+}
+
+// diffLocation lists the configuration fields which differ between the two locations
+func diffLocation(current, next *pb.Location) []string {
+	var diff []string
+	a, b := current.ProtoReflect(), next.ProtoReflect()
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if runtimeFields[field.Name()] {
+			continue
+		}
+		if fieldString(a, field) != fieldString(b, field) {
+			diff = append(diff, fmt.Sprintf("~ %v.%v: %v -> %v", next.GetName(), field.Name(), fieldString(a, field), fieldString(b, field)))
+		}
+	}
+	return diff
+}
+
+// validateConfig checks the config as if it were the whole organisation
+func validateConfig(config *pb.OrganisationConfig) error {
+	return invalidArgument(validateOrganisation(&pb.Organisation{
+		Locations:      config.GetLocations(),
+		Extractors:     config.GetExtractors(),
+		LabelAliases:   config.GetLabelAliases(),
+		LabelOverrides: config.GetLabelOverrides(),
+	}))
+}
+
+// applyKeyed merges the configured entries into the current ones, matching them on key; the
+// result is sorted by key to match the order the update RPCs keep
+func applyKeyed[K cmp.Ordered, V proto.Message](current, config []V, key func(V) K, kind string, prune bool) ([]V, []string) {
+	var diff []string
+	configured := make(map[K]V)
+	for _, entry := range config {
+		configured[key(entry)] = entry
+	}
+
+	var next []V
+	for _, entry := range current {
+		centry, ok := configured[key(entry)]
+		switch {
+		case ok && !proto.Equal(entry, centry):
+			diff = append(diff, fmt.Sprintf("~ %v %v: %v -> %v", kind, key(entry), prototext.MarshalOptions{}.Format(entry), prototext.MarshalOptions{}.Format(centry)))
+			next = append(next, centry)
+		case ok:
+			next = append(next, entry)
+		case prune:
+			diff = append(diff, fmt.Sprintf("- %v %v", kind, key(entry)))
+		default:
+			next = append(next, entry)
+		}
+		delete(configured, key(entry))
+	}
+	for _, entry := range config {
+		if _, ok := configured[key(entry)]; ok {
+			diff = append(diff, fmt.Sprintf("+ %v %v: %v", kind, key(entry), prototext.MarshalOptions{}.Format(entry)))
+			next = append(next, entry)
+			delete(configured, key(entry))
+		}
+	}
+
+	slices.SortStableFunc(next, func(a, b V) int { return cmp.Compare(key(a), key(b)) })
+	return next, diff
+}
+
+// applyConfig updates the organisation to match the config, returning the changes made
+func applyConfig(org *pb.Organisation, config *pb.OrganisationConfig, prune bool) []string {
+	var diff []string
+
+	current := make(map[string]*pb.Location)
+	for _, loc := range org.GetLocations() {
+		current[loc.GetName()] = loc
+	}
+	configured := make(map[string]bool)
+	for _, loc := range config.GetLocations() {
+		configured[loc.GetName()] = true
+	}
+
+	// Existing locations keep their place in the order, new ones go on the end
+	var locations []*pb.Location
+	for _, loc := range org.GetLocations() {
+		if !configured[loc.GetName()] {
+			if prune {
+				diff = append(diff, fmt.Sprintf("- %v", loc.GetName()))
+			} else {
+				locations = append(locations, loc)
+			}
+			continue
+		}
+		for _, cloc := range config.GetLocations() {
+			if cloc.GetName() == loc.GetName() {
+				next := configureLocation(loc, cloc)
+				diff = append(diff, diffLocation(loc, next)...)
+				locations = append(locations, next)
+			}
+		}
+	}
+	for _, cloc := range config.GetLocations() {
+		if current[cloc.GetName()] == nil {
+			diff = append(diff, fmt.Sprintf("+ %v", cloc.GetName()))
+			locations = append(locations, configureLocation(nil, cloc))
+		}
+	}
+	org.Locations = locations
+
+	extractors, extractorDiff := applyKeyed(org.GetExtractors(), config.GetExtractors(), (*pb.LabelExtractor).GetLabelId, "extractor", prune)
+	org.Extractors = extractors
+	diff = append(diff, extractorDiff...)
+
+	aliases, aliasDiff := applyKeyed(org.GetLabelAliases(), config.GetLabelAliases(), (*pb.LabelAlias).GetLabelId, "alias", prune)
+	org.LabelAliases = aliases
+	diff = append(diff, aliasDiff...)
+
+	overrides, overrideDiff := applyKeyed(org.GetLabelOverrides(), config.GetLabelOverrides(), (*pb.LabelOverride).GetInstanceId, "override", prune)
+	org.LabelOverrides = overrides
+	diff = append(diff, overrideDiff...)
+
+	return diff
+}
+
+// ApplyConfig brings the organisation in line with a declarative config
+func (s *Server) ApplyConfig(ctx context.Context, req *pb.ApplyConfigRequest) (*pb.ApplyConfigResponse, error) {
+	if err := validateConfig(req.GetConfig()); err != nil {
+		return nil, err
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before := validateOrganisation(org)
+	diff := applyConfig(org, req.GetConfig(), req.GetPrune())
+	if err := checkOrganisation(before, org); err != nil {
+		return nil, err
+	}
+	if req.GetDryRun() || len(diff) == 0 {
+		return &pb.ApplyConfigResponse{Diff: diff}, nil
+	}
+
+	return &pb.ApplyConfigResponse{Diff: diff, Applied: true}, s.saveOrg(ctx, org)
+}
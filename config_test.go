@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestApplyConfigKeepsPlacements(t *testing.T) {
+	org := &pb.Organisation{
+		Locations: []*pb.Location{
+			{Name: "first", Slots: 2, FolderIds: []int32{1}, LastReorg: 100, ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 12}}},
+			{Name: "old", Slots: 1},
+		},
+		Extractors: []*pb.LabelExtractor{{LabelId: 1, Extractor: "\\d+"}},
+	}
+	config := &pb.OrganisationConfig{
+		Locations: []*pb.Location{
+			{Name: "first", Slots: 3, FolderIds: []int32{1}, ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 99}}},
+			{Name: "new", Slots: 1},
+		},
+		Extractors: []*pb.LabelExtractor{{LabelId: 2, Extractor: "(\\d+)"}},
+	}
+
+	diff := applyConfig(org, config, false)
+	if len(diff) != 3 || !strings.Contains(diff[0], "first.slots: 2 -> 3") {
+		t.Errorf("Bad diff: %v", diff)
+	}
+	if len(org.GetLocations()) != 3 || org.GetLocations()[0].GetLastReorg() != 100 || org.GetLocations()[0].GetReleasesLocation()[0].GetInstanceId() != 12 {
+		t.Errorf("Runtime state was not kept: %v", org.GetLocations())
+	}
+	if len(org.GetExtractors()) != 2 {
+		t.Errorf("Bad extractors: %v", org.GetExtractors())
+	}
+
+	diff = applyConfig(org, config, true)
+	if len(org.GetLocations()) != 2 || len(org.GetExtractors()) != 1 || len(diff) != 2 {
+		t.Errorf("Prune failed: %v -> %v", diff, org)
+	}
+}
+
+func TestApplyConfigSortsExtractors(t *testing.T) {
+	org := &pb.Organisation{Extractors: []*pb.LabelExtractor{{LabelId: 5, Extractor: "(\\d+)"}, {LabelId: 2, Extractor: "(\\d+)"}}}
+	config := &pb.OrganisationConfig{Extractors: []*pb.LabelExtractor{{LabelId: 3, Extractor: "(\\d+)"}, {LabelId: 5, Extractor: "-(\\d+)"}}}
+
+	diff := applyConfig(org, config, false)
+	if len(diff) != 2 || len(org.GetExtractors()) != 3 {
+		t.Fatalf("Bad apply: %v -> %v", diff, org.GetExtractors())
+	}
+	for i, id := range []int32{2, 3, 5} {
+		if org.GetExtractors()[i].GetLabelId() != id {
+			t.Errorf("Extractors are out of order: %v", org.GetExtractors())
+		}
+	}
+}
+
+func TestApplyConfigSetsStockCheck(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{{Name: "first"}}}
+
+	diff := applyConfig(org, &pb.OrganisationConfig{Locations: []*pb.Location{{Name: "first", Checking: pb.Location_REQUIRE_STOCK_CHECK}}}, false)
+	if len(diff) != 1 || org.GetLocations()[0].GetChecking() != pb.Location_REQUIRE_STOCK_CHECK {
+		t.Errorf("Config did not set the stock check: %v -> %v", diff, org)
+	}
+
+	applyConfig(org, &pb.OrganisationConfig{Locations: []*pb.Location{{Name: "first"}}}, false)
+	if org.GetLocations()[0].GetChecking() == pb.Location_REQUIRE_STOCK_CHECK {
+		t.Errorf("Config did not reset the stock check: %v", org)
+	}
+}
+
+func TestApplyConfigLabelRules(t *testing.T) {
+	org := &pb.Organisation{
+		LabelAliases:   []*pb.LabelAlias{{LabelId: 1, CanonicalName: "Warp"}, {LabelId: 2, Family: "Warp"}},
+		LabelOverrides: []*pb.LabelOverride{{InstanceId: 10, Catno: "WARP 5"}},
+	}
+	config := &pb.OrganisationConfig{
+		LabelAliases:   []*pb.LabelAlias{{LabelId: 3, Family: "Kranky"}, {LabelId: 1, CanonicalName: "Warp Records"}},
+		LabelOverrides: []*pb.LabelOverride{{InstanceId: 10, Catno: "WARP 5"}, {InstanceId: 5, LabelId: 2}},
+	}
+
+	diff := applyConfig(org, config, false)
+	if len(diff) != 3 || len(org.GetLabelAliases()) != 3 || len(org.GetLabelOverrides()) != 2 {
+		t.Fatalf("Bad apply: %v -> %v", diff, org)
+	}
+	if org.GetLabelAliases()[0].GetCanonicalName() != "Warp Records" || org.GetLabelAliases()[2].GetLabelId() != 3 {
+		t.Errorf("Aliases were not updated in order: %v", org.GetLabelAliases())
+	}
+	if org.GetLabelOverrides()[0].GetInstanceId() != 5 {
+		t.Errorf("Overrides are out of order: %v", org.GetLabelOverrides())
+	}
+
+	diff = applyConfig(org, &pb.OrganisationConfig{LabelAliases: config.GetLabelAliases()}, true)
+	if len(diff) != 3 || len(org.GetLabelAliases()) != 2 || len(org.GetLabelOverrides()) != 0 {
+		t.Errorf("Prune failed: %v -> %v", diff, org)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	bad := []*pb.OrganisationConfig{
+		{Locations: []*pb.Location{{}}},
+		{Locations: []*pb.Location{{Name: "a"}, {Name: "a"}}},
+		{Locations: []*pb.Location{{Name: "a", FolderIds: []int32{1, 1}}}},
+		{Locations: []*pb.Location{{Name: "a", FolderOrder: map[int32]int32{2: 0}}}},
+		{Extractors: []*pb.LabelExtractor{{LabelId: 1, Extractor: "("}}},
+		{LabelAliases: []*pb.LabelAlias{{LabelId: 1}}},
+		{LabelAliases: []*pb.LabelAlias{{LabelId: 1, Family: "Warp"}, {LabelId: 1, CanonicalName: "Warp"}}},
+		{LabelOverrides: []*pb.LabelOverride{{Catno: "WARP 5"}}},
+		{LabelOverrides: []*pb.LabelOverride{{InstanceId: 1}}},
+	}
+	for _, config := range bad {
+		if err := validateConfig(config); err == nil {
+			t.Errorf("Bad config passed: %v", config)
+		}
+	}
+}
+
+func TestApplyConfigDryRun(t *testing.T) {
+	s := getTestServer(".applyConfig")
+	ctx := context.Background()
+	config := &pb.OrganisationConfig{Locations: []*pb.Location{{Name: "new", Slots: 4}}}
+
+	resp, err := s.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: config, DryRun: true})
+	if err != nil || resp.GetApplied() || len(resp.GetDiff()) != 1 {
+		t.Fatalf("Bad dry run: %v, %v", resp, err)
+	}
+	if org, _ := s.readOrg(ctx); len(org.GetLocations()) != 0 {
+		t.Errorf("Dry run changed the org: %v", org)
+	}
+
+	resp, err = s.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: config})
+	if err != nil || !resp.GetApplied() {
+		t.Fatalf("Bad apply: %v, %v", resp, err)
+	}
+	if org, _ := s.readOrg(ctx); len(org.GetLocations()) != 1 || org.GetLocations()[0].GetSlots() != 4 {
+		t.Errorf("Config was not applied: %v", org)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+const (
+	// The most records missing from the cache we look up in one coverage run
+	maxCoverageLookups = 200
+)
+
+// offShelf are the categories of records which have left the shelves, or never reach them
+var offShelf = map[string]bool{
+	pbrc.ReleaseMetadata_PREPARE_TO_SELL.String(): true,
+	pbrc.ReleaseMetadata_LISTED_TO_SELL.String():  true,
+	pbrc.ReleaseMetadata_STALE_SALE.String():      true,
+	pbrc.ReleaseMetadata_SALE_ISSUE.String():      true,
+	pbrc.ReleaseMetadata_SOLD.String():            true,
+	pbrc.ReleaseMetadata_SOLD_ARCHIVE.String():    true,
+	pbrc.ReleaseMetadata_SOLD_OFFLINE.String():    true,
+	pbrc.ReleaseMetadata_DIGITAL.String():         true,
+	pbrc.ReleaseMetadata_GOOGLE_PLAY.String():     true,
+	pbrc.ReleaseMetadata_BANDCAMP.String():        true,
+}
+
+// locationForFolder picks the location responsible for a folder; where several
+// locations claim it the earliest in the organisation wins
+func locationForFolder(org *pb.Organisation, folder int32) *pb.Location {
+	for _, loc := range org.GetLocations() {
+		for _, f := range loc.GetFolderIds() {
+			if f == folder {
+				return loc
+			}
+		}
+	}
+	return nil
+}
+
+// coverage reports folders and records the organisation does not account for, given
+// the full collection and the folder each unplaced record sits in
+func coverage(org *pb.Organisation, ids []int64, folders map[int64]int32) *pb.CoverageResponse {
+	resp := &pb.CoverageResponse{}
+
+	claims := make(map[int32][]string)
+	placed := make(map[int64]bool)
+	for _, loc := range org.GetLocations() {
+		for _, folder := range loc.GetFolderIds() {
+			claims[folder] = append(claims[folder], loc.GetName())
+		}
+		for _, rp := range loc.GetReleasesLocation() {
+			placed[rp.GetInstanceId()] = true
+		}
+	}
+
+	for folder, locations := range claims {
+		if len(locations) > 1 {
+			resp.Overlaps = append(resp.Overlaps, &pb.FolderOverlap{FolderId: folder, Locations: locations})
+		}
+	}
+	sort.Slice(resp.Overlaps, func(i, j int) bool { return resp.Overlaps[i].GetFolderId() < resp.Overlaps[j].GetFolderId() })
+
+	unowned := make(map[int32]*pb.UnownedFolder)
+	for _, id := range ids {
+		if placed[id] {
+			continue
+		}
+		resp.Unplaced = append(resp.Unplaced, id)
+
+		folder, ok := folders[id]
+		if !ok || len(claims[folder]) > 0 {
+			continue
+		}
+		if unowned[folder] == nil {
+			unowned[folder] = &pb.UnownedFolder{FolderId: folder}
+			resp.Unowned = append(resp.Unowned, unowned[folder])
+		}
+		unowned[folder].InstanceIds = append(unowned[folder].InstanceIds, id)
+	}
+	sort.Slice(resp.Unowned, func(i, j int) bool { return resp.Unowned[i].GetFolderId() < resp.Unowned[j].GetFolderId() })
+
+	return resp
+}
+
+// Coverage reports overlapping folders, unowned folders and records which are not on a shelf
+func (s *Server) Coverage(ctx context.Context, _ *pb.CoverageRequest) (*pb.CoverageResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.bridge.getAllReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	placed := make(map[int64]bool)
+	for _, loc := range org.GetLocations() {
+		for _, rp := range loc.GetReleasesLocation() {
+			placed[rp.GetInstanceId()] = true
+		}
+	}
+
+	// Only unplaced records need a folder and category, use the cache where we can
+	index := cacheIndex(cache)
+	folders := make(map[int64]int32)
+	shelved := make(map[int64]bool)
+	var lookups []int64
+	for _, id := range ids {
+		if placed[id] {
+			shelved[id] = true
+		} else if entry, ok := index[id]; ok {
+			shelved[id] = !offShelf[entry.GetCategory()]
+			folders[id] = entry.GetFolder()
+		} else {
+			lookups = append(lookups, id)
+		}
+	}
+
+	var unchecked []int64
+	if len(lookups) > maxCoverageLookups {
+		unchecked = lookups[maxCoverageLookups:]
+		lookups = lookups[:maxCoverageLookups]
+	}
+
+	var funcErr error
+	lock := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	guard := make(chan struct{}, 20)
+	for _, id := range lookups {
+		guard <- struct{}{}
+		wg.Add(1)
+		go func(iid int64) {
+			rec, err := s.bridge.getRecord(ctx, iid)
+			lock.Lock()
+			if err != nil {
+				funcErr = err
+			} else {
+				shelved[iid] = !offShelf[rec.GetMetadata().GetCategory().String()]
+				folders[iid] = rec.GetRelease().GetFolderId()
+			}
+			lock.Unlock()
+			wg.Done()
+			<-guard
+		}(id)
+	}
+	wg.Wait()
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	var shelf []int64
+	for _, id := range ids {
+		if shelved[id] {
+			shelf = append(shelf, id)
+		}
+	}
+
+	resp := coverage(org, shelf, folders)
+	resp.Unchecked = unchecked
+	return resp, nil
+}
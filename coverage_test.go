@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestLocationForFolderPicksFirst(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{
+		{Name: "first", FolderIds: []int32{1, 2}},
+		{Name: "second", FolderIds: []int32{2}},
+	}}
+
+	for i := 0; i < 10; i++ {
+		if loc := locationForFolder(org, 2); loc.GetName() != "first" {
+			t.Fatalf("Wrong location: %v", loc)
+		}
+	}
+	if loc := locationForFolder(org, 3); loc != nil {
+		t.Errorf("Found location for unclaimed folder: %v", loc)
+	}
+
+	loc, _ := locate(org, &pb.LocateRequest{FolderId: 2})
+	if loc.GetName() != "first" {
+		t.Errorf("Locate picked the wrong location: %v", loc)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{
+		{Name: "first", FolderIds: []int32{1, 2}, ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1}}},
+		{Name: "second", FolderIds: []int32{2}},
+	}}
+
+	resp := coverage(org, []int64{1, 2, 3, 4}, map[int64]int32{2: 1, 3: 5, 4: 5})
+
+	if len(resp.GetOverlaps()) != 1 || resp.GetOverlaps()[0].GetFolderId() != 2 || len(resp.GetOverlaps()[0].GetLocations()) != 2 {
+		t.Errorf("Bad overlaps: %v", resp.GetOverlaps())
+	}
+	if len(resp.GetUnowned()) != 1 || resp.GetUnowned()[0].GetFolderId() != 5 || len(resp.GetUnowned()[0].GetInstanceIds()) != 2 {
+		t.Errorf("Bad unowned: %v", resp.GetUnowned())
+	}
+	if len(resp.GetUnplaced()) != 3 {
+		t.Errorf("Bad unplaced: %v", resp.GetUnplaced())
+	}
+}
+
+func TestCoverageSkipsSoldRecords(t *testing.T) {
+	s := getTestServer(".coverageSold")
+	ctx := context.Background()
+
+	// Record 1 has been sold, record 2 is missing from the cache and gets looked up
+	cache := &pb.SortingCache{}
+	appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: 1}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_SOLD_ARCHIVE}}, nil, nil)
+	if err := s.saveCache(ctx, cache); err != nil {
+		t.Fatalf("Unable to save cache: %v", err)
+	}
+
+	resp, err := s.Coverage(ctx, &pb.CoverageRequest{})
+	if err != nil {
+		t.Fatalf("Unable to get coverage: %v", err)
+	}
+	if len(resp.GetUnplaced()) != 1 || resp.GetUnplaced()[0] != 2 || len(resp.GetUnchecked()) != 0 {
+		t.Errorf("Bad coverage: %v", resp)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// sortDivergences lists the positions where the live and cached label sorts disagree
+func sortDivergences(live []*pbrc.Record, cached []int64) []*pb.SortDivergence {
+	var divergences []*pb.SortDivergence
+	for i := range live {
+		if i < len(cached) && live[i].GetRelease().GetInstanceId() != cached[i] {
+			divergences = append(divergences, &pb.SortDivergence{
+				Position:         int32(i),
+				LiveInstanceId:   live[i].GetRelease().GetInstanceId(),
+				CachedInstanceId: cached[i],
+			})
+		}
+	}
+	return divergences
+}
+
+// staleEntries lists the records whose cache entry predates the label key
+func staleEntries(records []*pbrc.Record, cache *pb.SortingCache) []int64 {
+	index := cacheIndex(cache)
+	var stale []int64
+	for _, rec := range records {
+		if entry, ok := index[rec.GetRelease().GetInstanceId()]; ok && entry.GetLabelKey() == nil {
+			stale = append(stale, rec.GetRelease().GetInstanceId())
+		}
+	}
+	return stale
+}
+
+// labelDivergences sorts the records both ways, considering only those the cache has a label key for
+func labelDivergences(records []*pbrc.Record, cache *pb.SortingCache, extractors map[int32]*regexp.Regexp, rules *labelRules) []*pb.SortDivergence {
+	index := cacheIndex(cache)
+	var live []*pbrc.Record
+	for _, rec := range records {
+		if entry, ok := index[rec.GetRelease().GetInstanceId()]; ok && entry.GetLabelKey() != nil {
+			live = append(live, rec)
+		}
+	}
+	sort.SliceStable(live, func(i, j int) bool {
+		return live[i].GetRelease().GetInstanceId() < live[j].GetRelease().GetInstanceId()
+	})
+
+	var cached []int64
+	for _, rec := range live {
+		cached = append(cached, rec.GetRelease().GetInstanceId())
+	}
+
+	sortByLabel(live, extractors, rules)
+	sortCachedByLabel(cached, cache)
+
+	return sortDivergences(live, cached)
+}
+
+// SortDivergence reports where the cached label sort would shelve records differently to the live one
+func (s *Server) SortDivergence(ctx context.Context, req *pb.SortDivergenceRequest) (*pb.SortDivergenceResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, name := range req.GetLocations() {
+		wanted[name] = true
+	}
+
+	extractors := compileExtractors(convert(org.GetExtractors()))
+	rules := newLabelRules(org)
+	resp := &pb.SortDivergenceResponse{}
+	for _, loc := range org.GetLocations() {
+		if len(wanted) > 0 && !wanted[loc.GetName()] {
+			continue
+		}
+
+		for _, folder := range loc.GetFolderIds() {
+			if loc.GetFolderSort()[folder] != pb.Location_BY_LABEL_CATNO {
+				continue
+			}
+
+			ids, err := s.bridge.getReleases(ctx, []int32{folder})
+			if err != nil {
+				return nil, err
+			}
+			var records []*pbrc.Record
+			for _, id := range ids {
+				rec, err := s.bridge.getRecord(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				records = append(records, rec)
+			}
+
+			for _, d := range labelDivergences(records, cache, extractors, rules) {
+				d.Location = loc.GetName()
+				d.Folder = folder
+				resp.Divergences = append(resp.Divergences, d)
+			}
+			resp.Stale = append(resp.Stale, staleEntries(records, cache)...)
+			resp.Checked += int32(len(records))
+		}
+	}
+
+	return resp, nil
+}
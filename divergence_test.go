@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// The live and cached label sorts must always shelve records the same way
+func TestLabelSortInvariant(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	labels := []string{"Warp", "warp", "Kranky", "Rough Trade", ""}
+	formats := []string{"%v %03d", "%vLP%d", "%v-%d-%d", "%v %d/%d", "%v"}
+	extractors := compileExtractors(map[int32]string{0: "(\\d+)", 2: "[A-Z]+-(\\d+)-(\\d+)"})
+
+	for run := 0; run < 20; run++ {
+		cache := &pb.SortingCache{}
+		var records []*pbrc.Record
+		for i := int64(0); i < 50; i++ {
+			prefix := fmt.Sprintf("%c%c", 'A'+r.Intn(3), 'A'+r.Intn(3))
+			catno := fmt.Sprintf(formats[r.Intn(len(formats))], prefix, r.Intn(200), r.Intn(20))
+			rec := &pbrc.Record{Release: &pbd.Release{InstanceId: i, Title: fmt.Sprintf("Title %v", r.Intn(10))}}
+			if label := r.Intn(len(labels) + 1); label < len(labels) {
+				rec.Release.Labels = []*pbd.Label{{Id: int32(label), Name: labels[label], Catno: catno}}
+			}
+			records = append(records, rec)
+			appendCache(cache, rec, extractors, nil)
+		}
+
+		if d := labelDivergences(records, cache, extractors, nil); len(d) > 0 {
+			t.Fatalf("Run %v diverged: %v", run, d)
+		}
+	}
+}
+
+func TestStaleCacheDiverges(t *testing.T) {
+	records := []*pbrc.Record{
+		{Release: &pbd.Release{InstanceId: 1, Labels: []*pbd.Label{{Id: 1, Name: "Warp", Catno: "WARP-2-1"}}}},
+		{Release: &pbd.Release{InstanceId: 2, Labels: []*pbd.Label{{Id: 1, Name: "Warp", Catno: "WARP-1-2"}}}},
+	}
+
+	// The cache was built before the extractor was added
+	cache := &pb.SortingCache{}
+	for _, rec := range records {
+		appendCache(cache, rec, nil, nil)
+	}
+
+	d := labelDivergences(records, cache, compileExtractors(map[int32]string{1: "-\\d+-(\\d+)"}), nil)
+	if len(d) != 2 || d[0].GetLiveInstanceId() != 1 || d[0].GetCachedInstanceId() != 2 {
+		t.Errorf("Bad divergences: %v", d)
+	}
+}
+
+func TestLegacyCacheIsStale(t *testing.T) {
+	records := []*pbrc.Record{
+		{Release: &pbd.Release{InstanceId: 1, Labels: []*pbd.Label{{Id: 1, Name: "Warp", Catno: "WARP 2"}}}},
+		{Release: &pbd.Release{InstanceId: 2, Labels: []*pbd.Label{{Id: 1, Name: "Warp", Catno: "WARP 1"}}}},
+	}
+
+	// Entries written before the cache carried a label key
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{{InstanceId: 1}, {InstanceId: 2}}}
+
+	if d := labelDivergences(records, cache, nil, nil); len(d) > 0 {
+		t.Errorf("Legacy entries were compared: %v", d)
+	}
+	if stale := staleEntries(records, cache); len(stale) != 2 {
+		t.Errorf("Bad stale entries: %v", stale)
+	}
+}
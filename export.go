@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+type exportPlacement struct {
+	Index           int32   `json:"index"`
+	InstanceID      int64   `json:"instance_id"`
+	ReleaseID       int32   `json:"release_id,omitempty"`
+	Artist          string  `json:"artist,omitempty"`
+	Title           string  `json:"title"`
+	Label           string  `json:"label,omitempty"`
+	Catno           string  `json:"catno,omitempty"`
+	Format          string  `json:"format,omitempty"`
+	SizeClass       string  `json:"size_class,omitempty"`
+	Folder          int32   `json:"folder,omitempty"`
+	Category        string  `json:"category,omitempty"`
+	Sleeve          string  `json:"sleeve,omitempty"`
+	Width           float64 `json:"width,omitempty"`
+	DeterminedWidth float32 `json:"determined_width"`
+}
+
+type exportSlot struct {
+	Slot       int32             `json:"slot"`
+	Path       string            `json:"path,omitempty"`
+	Capacity   float32           `json:"capacity,omitempty"`
+	Placements []exportPlacement `json:"placements"`
+}
+
+type exportLocation struct {
+	Name  string       `json:"name"`
+	Slots []exportSlot `json:"slots"`
+}
+
+func pathString(path *pb.PhysicalPath) string {
+	if path == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v, %v, row %v, column %v", path.GetRoom(), path.GetUnit(), path.GetRow(), path.GetColumn())
+}
+
+func buildExport(org *pb.Organisation, cache *pb.SortingCache, names []string) ([]exportLocation, error) {
+	entries := cacheIndex(cache)
+
+	var locations []*pb.Location
+	if len(names) == 0 {
+		locations = org.GetLocations()
+	}
+	for _, name := range names {
+		found := false
+		for _, loc := range org.GetLocations() {
+			if loc.GetName() == name {
+				locations = append(locations, loc)
+				found = true
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "Unable to find location %v", name)
+		}
+	}
+
+	var export []exportLocation
+	for _, loc := range locations {
+		eloc := exportLocation{Name: loc.GetName(), Slots: []exportSlot{}}
+		for _, view := range locationViews(loc, entries) {
+			rp := view.GetPlacement()
+			if len(eloc.Slots) == 0 || eloc.Slots[len(eloc.Slots)-1].Slot != rp.GetSlot() {
+				eloc.Slots = append(eloc.Slots, exportSlot{
+					Slot:     rp.GetSlot(),
+					Path:     pathString(physicalPath(loc, rp.GetSlot())),
+					Capacity: slotCapacity(loc, int(rp.GetSlot())),
+				})
+			}
+			slot := &eloc.Slots[len(eloc.Slots)-1]
+			slot.Placements = append(slot.Placements, exportPlacement{
+				Index:           rp.GetIndex(),
+				InstanceID:      rp.GetInstanceId(),
+				ReleaseID:       view.GetReleaseId(),
+				Artist:          view.GetArtist(),
+				Title:           rp.GetTitle(),
+				Label:           view.GetLabel(),
+				Catno:           view.GetCatno(),
+				Format:          view.GetFormat(),
+				SizeClass:       view.GetSizeClass(),
+				Folder:          view.GetFolder(),
+				Category:        view.GetCategory(),
+				Sleeve:          view.GetSleeve(),
+				Width:           view.GetWidth(),
+				DeterminedWidth: rp.GetDeterminedWidth(),
+			})
+		}
+		export = append(export, eloc)
+	}
+	return export, nil
+}
+
+func exportCSV(export []exportLocation) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Write([]string{"location", "slot", "path", "index", "instance_id", "release_id", "artist", "title", "label", "catno", "format", "size_class", "folder", "category", "sleeve", "width", "determined_width"})
+	for _, loc := range export {
+		for _, slot := range loc.Slots {
+			for _, p := range slot.Placements {
+				w.Write([]string{
+					loc.Name, fmt.Sprintf("%v", slot.Slot), slot.Path,
+					fmt.Sprintf("%v", p.Index), fmt.Sprintf("%v", p.InstanceID), fmt.Sprintf("%v", p.ReleaseID),
+					p.Artist, p.Title, p.Label, p.Catno, p.Format, p.SizeClass,
+					fmt.Sprintf("%v", p.Folder), p.Category, p.Sleeve,
+					fmt.Sprintf("%v", p.Width), fmt.Sprintf("%v", p.DeterminedWidth),
+				})
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// markdownCell stops a value from breaking the table
+func markdownCell(val string) string {
+	return strings.ReplaceAll(val, "|", "\\|")
+}
+
+func exportMarkdown(export []exportLocation) []byte {
+	buf := &bytes.Buffer{}
+	for _, loc := range export {
+		fmt.Fprintf(buf, "## %v\n\n", loc.Name)
+		for _, slot := range loc.Slots {
+			fmt.Fprintf(buf, "### Slot %v", slot.Slot)
+			if slot.Path != "" {
+				fmt.Fprintf(buf, " (%v)", slot.Path)
+			}
+			buf.WriteString("\n\n| # | Artist | Title | Label | Catno | Format |\n|---|---|---|---|---|---|\n")
+			for _, p := range slot.Placements {
+				fmt.Fprintf(buf, "| %v | %v | %v | %v | %v | %v |\n", p.Index+1, markdownCell(p.Artist), markdownCell(p.Title), markdownCell(p.Label), markdownCell(p.Catno), markdownCell(p.Format))
+			}
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes()
+}
+
+func exportOrganisation(org *pb.Organisation, cache *pb.SortingCache, req *pb.ExportOrganisationRequest) (*pb.ExportOrganisationResponse, error) {
+	export, err := buildExport(org, cache, req.GetLocations())
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetFormat() {
+	case pb.ExportOrganisationRequest_CSV:
+		data, err := exportCSV(export)
+		return &pb.ExportOrganisationResponse{Data: data, ContentType: "text/csv"}, err
+	case pb.ExportOrganisationRequest_JSON:
+		data, err := json.MarshalIndent(export, "", "  ")
+		return &pb.ExportOrganisationResponse{Data: data, ContentType: "application/json"}, err
+	case pb.ExportOrganisationRequest_MARKDOWN:
+		return &pb.ExportOrganisationResponse{Data: exportMarkdown(export), ContentType: "text/markdown"}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "Unknown export format %v", req.GetFormat())
+}
+
+// ExportOrganisation writes out the arrangement of the locations
+func (s *Server) ExportOrganisation(ctx context.Context, req *pb.ExportOrganisationRequest) (*pb.ExportOrganisationResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportOrganisation(org, cache, req)
+}
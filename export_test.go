@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func testExportData() (*pb.Organisation, *pb.SortingCache) {
+	org := &pb.Organisation{Locations: []*pb.Location{
+		{Name: "first", ReleasesLocation: []*pb.ReleasePlacement{
+			{InstanceId: 1, Slot: 1, Title: "Drukqs"},
+			{InstanceId: 2, Slot: 2, Title: "Geogaddi | Remastered"},
+		}},
+		{Name: "second", ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 3, Slot: 1, Title: "Tri Repetae"}}},
+	}}
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{{InstanceId: 1, Artist: "Aphex Twin", MainLabel: "Warp", Catno: "WARPCD92"}}}
+	return org, cache
+}
+
+func TestExportCSV(t *testing.T) {
+	org, cache := testExportData()
+	resp, err := exportOrganisation(org, cache, &pb.ExportOrganisationRequest{Format: pb.ExportOrganisationRequest_CSV})
+	if err != nil {
+		t.Fatalf("Unable to export: %v", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(resp.GetData())).ReadAll()
+	if err != nil || len(rows) != 4 {
+		t.Fatalf("Bad csv: %v, %v", rows, err)
+	}
+	if rows[1][0] != "first" || rows[1][6] != "Aphex Twin" || rows[1][9] != "WARPCD92" {
+		t.Errorf("Bad row: %v", rows[1])
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	org, cache := testExportData()
+	resp, err := exportOrganisation(org, cache, &pb.ExportOrganisationRequest{Format: pb.ExportOrganisationRequest_JSON, Locations: []string{"first"}})
+	if err != nil {
+		t.Fatalf("Unable to export: %v", err)
+	}
+
+	var export []exportLocation
+	if err := json.Unmarshal(resp.GetData(), &export); err != nil {
+		t.Fatalf("Bad json: %v", err)
+	}
+	if len(export) != 1 || len(export[0].Slots) != 2 || export[0].Slots[0].Placements[0].Label != "Warp" {
+		t.Errorf("Bad export: %v", export)
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	org, cache := testExportData()
+	resp, err := exportOrganisation(org, cache, &pb.ExportOrganisationRequest{Format: pb.ExportOrganisationRequest_MARKDOWN})
+	if err != nil {
+		t.Fatalf("Unable to export: %v", err)
+	}
+
+	md := string(resp.GetData())
+	if !strings.Contains(md, "## second") || !strings.Contains(md, "### Slot 2") || !strings.Contains(md, "Geogaddi \\| Remastered") {
+		t.Errorf("Bad markdown: %v", md)
+	}
+}
+
+func TestExportMissingLocation(t *testing.T) {
+	org, cache := testExportData()
+	if _, err := exportOrganisation(org, cache, &pb.ExportOrganisationRequest{Locations: []string{"madeup"}}); err == nil {
+		t.Errorf("Missing location was exported")
+	}
+}
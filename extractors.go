@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// extractorProblem describes what is wrong with the extractor, if anything; the sort uses
+// the first capture group so an extractor without one can never work
+func extractorProblem(ex *pb.LabelExtractor) string {
+	r, err := regexp.Compile(ex.GetExtractor())
+	if err != nil {
+		return fmt.Sprintf("does not compile: %v", err)
+	}
+	if r.NumSubexp() == 0 {
+		return fmt.Sprintf("%q has no capture group", ex.GetExtractor())
+	}
+	return ""
+}
+
+// testExtractor sorts the cached records on the extractor's label using the extractor
+func testExtractor(ex *pb.LabelExtractor, cache *pb.SortingCache) *pb.TestExtractorResponse {
+	var entries []*pb.CacheEntry
+	for _, entry := range cache.GetCache() {
+		if entry.GetLabelId() == ex.GetLabelId() {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].GetInstanceId() < entries[j].GetInstanceId() })
+
+	extractors := compileExtractors(map[int32]string{ex.GetLabelId(): ex.GetExtractor()})
+	releases := make(map[int64]*pbgd.Release)
+	for _, entry := range entries {
+		releases[entry.GetInstanceId()] = &pbgd.Release{
+			InstanceId: entry.GetInstanceId(),
+			Title:      entry.GetLabelKey().GetTitle(),
+			Labels:     []*pbgd.Label{{Id: entry.GetLabelId(), Name: entry.GetMainLabel(), Catno: entry.GetCatno()}},
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return sortByLabelCat(releases[entries[i].GetInstanceId()], releases[entries[j].GetInstanceId()], extractors, nil) < 0
+	})
+
+	resp := &pb.TestExtractorResponse{}
+	for _, entry := range entries {
+		parts := doExtractorSplit(releases[entry.GetInstanceId()].GetLabels()[0], extractors)
+		matched := len(parts) > 0
+		if !matched {
+			parts = split(strings.ToLower(entry.GetCatno()))
+			resp.Unmatched++
+		}
+		resp.Sorted = append(resp.Sorted, &pb.ExtractedCatno{InstanceId: entry.GetInstanceId(), Catno: entry.GetCatno(), Parts: parts, Matched: matched})
+	}
+	return resp
+}
+
+// ListExtractors lists the label extractors
+func (s *Server) ListExtractors(ctx context.Context, _ *pb.ListExtractorsRequest) (*pb.ListExtractorsResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	extractors := append([]*pb.LabelExtractor{}, org.GetExtractors()...)
+	sort.SliceStable(extractors, func(i, j int) bool { return extractors[i].GetLabelId() < extractors[j].GetLabelId() })
+	return &pb.ListExtractorsResponse{Extractors: extractors}, nil
+}
+
+// RemoveExtractor removes the extractor for a label
+func (s *Server) RemoveExtractor(ctx context.Context, req *pb.RemoveExtractorRequest) (*pb.RemoveExtractorResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var extractors []*pb.LabelExtractor
+	for _, ex := range org.GetExtractors() {
+		if ex.GetLabelId() != req.GetLabelId() {
+			extractors = append(extractors, ex)
+		}
+	}
+	if len(extractors) == len(org.GetExtractors()) {
+		return nil, status.Errorf(codes.NotFound, "No extractor for label %v", req.GetLabelId())
+	}
+
+	org.Extractors = extractors
+	return &pb.RemoveExtractorResponse{}, s.saveOrg(ctx, org)
+}
+
+// TestExtractor shows how an extractor would sort the label without saving it
+func (s *Server) TestExtractor(ctx context.Context, req *pb.TestExtractorRequest) (*pb.TestExtractorResponse, error) {
+	if problem := extractorProblem(req.GetExtractor()); problem != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Bad extractor: %v", problem)
+	}
+
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return testExtractor(req.GetExtractor(), cache), nil
+}
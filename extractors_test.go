@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestAddExtractorValidates(t *testing.T) {
+	s := getTestServer(".extractorValidates")
+	ctx := context.Background()
+
+	for _, bad := range []string{"(", "hello"} {
+		if _, err := s.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 12, Extractor: bad}}); err == nil {
+			t.Errorf("Bad extractor %q was added", bad)
+		}
+	}
+}
+
+func TestExtractorLifecycle(t *testing.T) {
+	s := getTestServer(".extractorLifecycle")
+	ctx := context.Background()
+
+	s.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 12, Extractor: "(\\d+)"}})
+	s.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 5, Extractor: "(\\d+)"}})
+	s.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 12, Extractor: "-(\\d+)"}})
+
+	list, err := s.ListExtractors(ctx, &pb.ListExtractorsRequest{})
+	if err != nil || len(list.GetExtractors()) != 3 || list.GetExtractors()[0].GetLabelId() != 5 || list.GetExtractors()[1].GetExtractor() != "-(\\d+)" {
+		t.Fatalf("Bad list: %v, %v", list, err)
+	}
+
+	if _, err := s.RemoveExtractor(ctx, &pb.RemoveExtractorRequest{LabelId: 12}); err != nil {
+		t.Errorf("Unable to remove: %v", err)
+	}
+	if _, err := s.RemoveExtractor(ctx, &pb.RemoveExtractorRequest{LabelId: 12}); err == nil {
+		t.Errorf("Removed a missing extractor")
+	}
+	if list, _ := s.ListExtractors(ctx, &pb.ListExtractorsRequest{}); len(list.GetExtractors()) != 2 {
+		t.Errorf("Extractor was not removed: %v", list)
+	}
+}
+
+func TestTestExtractor(t *testing.T) {
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{
+		{InstanceId: 1, LabelId: 10, MainLabel: "Warp", Catno: "WARPLP 100"},
+		{InstanceId: 2, LabelId: 10, MainLabel: "Warp", Catno: "WARP 20"},
+		{InstanceId: 3, LabelId: 10, MainLabel: "Warp", Catno: "WAP3"},
+		{InstanceId: 4, LabelId: 11, MainLabel: "Other", Catno: "OTHER 1"},
+	}}
+
+	resp := testExtractor(&pb.LabelExtractor{LabelId: 10, Extractor: "WARP[A-Z]* (\\d+)"}, cache)
+	if len(resp.GetSorted()) != 3 || resp.GetUnmatched() != 1 {
+		t.Fatalf("Bad test: %v", resp)
+	}
+	// Unmatched catnos fall back to splitting, which puts WAP ahead of WARP
+	if resp.GetSorted()[0].GetInstanceId() != 3 || resp.GetSorted()[1].GetInstanceId() != 2 || resp.GetSorted()[2].GetInstanceId() != 1 {
+		t.Errorf("Extractor did not drive the order: %v", resp.GetSorted())
+	}
+	if resp.GetSorted()[0].GetMatched() || !resp.GetSorted()[1].GetMatched() || resp.GetSorted()[1].GetParts()[0] != "20" {
+		t.Errorf("Bad parts: %v", resp.GetSorted())
+	}
+}
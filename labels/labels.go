@@ -0,0 +1,71 @@
+// Package labels renders divider cards for the slots in a location
+package labels
+
+import (
+	"fmt"
+
+	pbro "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// Card is a single divider card
+type Card struct {
+	Location string
+	Heading  string
+	From     string
+	To       string
+}
+
+// describe gives the label and catno of a record, falling back to the artist
+func describe(view *pbro.PlacementView) string {
+	if view.GetLabel() != "" {
+		return fmt.Sprintf("%v %v", view.GetLabel(), view.GetCatno())
+	}
+	if view.GetArtist() != "" {
+		return view.GetArtist()
+	}
+	return view.GetPlacement().GetTitle()
+}
+
+// runs splits the views wherever the key changes
+func runs(views []*pbro.PlacementView, key func(*pbro.PlacementView) int32) [][]*pbro.PlacementView {
+	var result [][]*pbro.PlacementView
+	for i, view := range views {
+		if i == 0 || key(view) != key(views[i-1]) {
+			result = append(result, []*pbro.PlacementView{})
+		}
+		result[len(result)-1] = append(result[len(result)-1], view)
+	}
+	return result
+}
+
+// Cards builds a divider card for each slot, followed by one for each folder group
+// within the slot where the slot holds more than one folder or the folder has a hard gap
+func Cards(loc *pbro.Location, views []*pbro.PlacementView) []Card {
+	var cards []Card
+	for _, slot := range runs(views, func(v *pbro.PlacementView) int32 { return v.GetPlacement().GetSlot() }) {
+		cards = append(cards, Card{
+			Location: loc.GetName(),
+			Heading:  fmt.Sprintf("Slot %v", slot[0].GetPlacement().GetSlot()),
+			From:     describe(slot[0]),
+			To:       describe(slot[len(slot)-1]),
+		})
+
+		groups := runs(slot, func(v *pbro.PlacementView) int32 { return v.GetFolder() })
+		for _, group := range groups {
+			gap := loc.GetHardGap()[group[0].GetFolder()]
+			if len(groups) > 1 || gap {
+				heading := fmt.Sprintf("Folder %v", group[0].GetFolder())
+				if gap {
+					heading += " (gap)"
+				}
+				cards = append(cards, Card{
+					Location: loc.GetName(),
+					Heading:  heading,
+					From:     describe(group[0]),
+					To:       describe(group[len(group)-1]),
+				})
+			}
+		}
+	}
+	return cards
+}
@@ -0,0 +1,55 @@
+package labels
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pbro "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func testViews() []*pbro.PlacementView {
+	return []*pbro.PlacementView{
+		{Folder: 1, Label: "Warp", Catno: "WARP12", Placement: &pbro.ReleasePlacement{Slot: 1}},
+		{Folder: 1, Label: "Warp", Catno: "WARP40", Placement: &pbro.ReleasePlacement{Slot: 1}},
+		{Folder: 2, Artist: "Frank Turner", Placement: &pbro.ReleasePlacement{Slot: 1}},
+		{Folder: 2, Label: "Xtra Mile", Catno: "XM045", Placement: &pbro.ReleasePlacement{Slot: 2}},
+	}
+}
+
+func TestCards(t *testing.T) {
+	cards := Cards(&pbro.Location{Name: "Lounge", HardGap: map[int32]bool{2: true}}, testViews())
+
+	headings := []string{"Slot 1", "Folder 1", "Folder 2 (gap)", "Slot 2", "Folder 2 (gap)"}
+	if len(cards) != len(headings) {
+		t.Fatalf("Bad cards: %v", cards)
+	}
+	for i, card := range cards {
+		if card.Heading != headings[i] || card.Location != "Lounge" {
+			t.Errorf("Bad card %v: %v", i, card)
+		}
+	}
+	if cards[0].From != "Warp WARP12" || cards[0].To != "Frank Turner" {
+		t.Errorf("Bad slot range: %v", cards[0])
+	}
+}
+
+func TestRender(t *testing.T) {
+	cards := Cards(&pbro.Location{Name: "Lounge (left)"}, testViews())
+
+	svg := &bytes.Buffer{}
+	if err := RenderSVG(svg, cards); err != nil {
+		t.Fatalf("Unable to render svg: %v", err)
+	}
+	if !strings.Contains(svg.String(), "Warp WARP12") || strings.Count(svg.String(), "<rect") != len(cards) {
+		t.Errorf("Bad svg: %v", svg.String())
+	}
+
+	pdf := &bytes.Buffer{}
+	if err := RenderPDF(pdf, cards); err != nil {
+		t.Fatalf("Unable to render pdf: %v", err)
+	}
+	if !strings.HasPrefix(pdf.String(), "%PDF-1.4") || !strings.HasSuffix(pdf.String(), "%%EOF\n") || !strings.Contains(pdf.String(), "(Lounge \\(left\\)) Tj") {
+		t.Errorf("Bad pdf: %v", pdf.String())
+	}
+}
@@ -0,0 +1,142 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// Cards are laid out two across and five down on an A4 page, all sizes in mm
+const (
+	pageWidth  = 210.0
+	pageHeight = 297.0
+	cardWidth  = 90.0
+	cardHeight = 50.0
+	columns    = 2
+	rows       = 5
+	perPage    = columns * rows
+	marginX    = (pageWidth - columns*cardWidth) / 2
+	marginY    = (pageHeight - rows*cardHeight) / 2
+	mmToPt     = 72 / 25.4
+)
+
+// cardLine is a line of text on a card, with its offset from the top of the card and font size
+type cardLine struct {
+	text string
+	y    float64
+	size float64
+}
+
+func cardLines(card Card) []cardLine {
+	return []cardLine{
+		{card.Location, 8, 8},
+		{card.Heading, 20, 16},
+		{card.From, 32, 10},
+		{"– " + card.To, 42, 10},
+	}
+}
+
+// cardOrigin gives the top left corner of the card on its page
+func cardOrigin(i int) (float64, float64) {
+	pos := i % perPage
+	return marginX + float64(pos%columns)*cardWidth, marginY + float64(pos/columns)*cardHeight
+}
+
+func pages(cards []Card) int {
+	if len(cards) == 0 {
+		return 1
+	}
+	return (len(cards) + perPage - 1) / perPage
+}
+
+// RenderSVG writes the cards as a single SVG with the pages stacked vertically
+func RenderSVG(w io.Writer, cards []Card) error {
+	height := pageHeight * float64(pages(cards))
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%vmm\" height=\"%vmm\" viewBox=\"0 0 %v %v\" font-family=\"Helvetica, Arial, sans-serif\">\n", pageWidth, height, pageWidth, height)
+	for i, card := range cards {
+		x, y := cardOrigin(i)
+		y += float64(i/perPage) * pageHeight
+		fmt.Fprintf(buf, "<rect x=\"%.1f\" y=\"%.1f\" width=\"%v\" height=\"%v\" fill=\"none\" stroke=\"black\" stroke-width=\"0.2\" stroke-dasharray=\"2,2\"/>\n", x, y, cardWidth, cardHeight)
+		for _, line := range cardLines(card) {
+			fmt.Fprintf(buf, "<text x=\"%.1f\" y=\"%.1f\" font-size=\"%v\">%v</text>\n", x+5, y+line.y, line.size*0.3528, html.EscapeString(line.text))
+		}
+	}
+	buf.WriteString("</svg>\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pdfString encodes text as a PDF literal string in WinAnsiEncoding
+func pdfString(text string) string {
+	var sb strings.Builder
+	sb.WriteString("(")
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		case r == '–':
+			sb.WriteString("\\226")
+		case r >= 32 && r < 127:
+			sb.WriteRune(r)
+		case r >= 160 && r < 256:
+			sb.WriteString(fmt.Sprintf("\\%03o", r))
+		default:
+			sb.WriteRune('?')
+		}
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// RenderPDF writes the cards as an A4 PDF
+func RenderPDF(w io.Writer, cards []Card) error {
+	buf := &bytes.Buffer{}
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(buf, "%v 0 obj\n%v\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-3 are the catalog, page tree and font, each page then takes a page and a content object
+	npages := pages(cards)
+	var kids []string
+	for p := 0; p < npages; p++ {
+		kids = append(kids, fmt.Sprintf("%v 0 R", 4+2*p))
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%v] /Count %v >>", strings.Join(kids, " "), npages))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+
+	for p := 0; p < npages; p++ {
+		content := &bytes.Buffer{}
+		content.WriteString("0.5 w [3 3] 0 d\n")
+		for i := p * perPage; i < len(cards) && i < (p+1)*perPage; i++ {
+			x, y := cardOrigin(i)
+			// PDF measures from the bottom of the page
+			fmt.Fprintf(content, "%.2f %.2f %.2f %.2f re S\n", x*mmToPt, (pageHeight-y-cardHeight)*mmToPt, cardWidth*mmToPt, cardHeight*mmToPt)
+			for _, line := range cardLines(cards[i]) {
+				fmt.Fprintf(content, "BT /F1 %v Tf %.2f %.2f Td %v Tj ET\n", line.size, (x+5)*mmToPt, (pageHeight-y-line.y)*mmToPt, pdfString(line.text))
+			}
+		}
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R >> >> /Contents %v 0 R >>", pageWidth*mmToPt, pageHeight*mmToPt, 5+2*p))
+		object(fmt.Sprintf("<< /Length %v >>\nstream\n%vendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %v\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %v /Root 1 0 R >>\nstartxref\n%v\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// deleteLocationMetrics drops every metric series labelled with the location
+func deleteLocationMetrics(name string) {
+	for _, vec := range []*prometheus.GaugeVec{sizes, swidths, scapacity, twidth, gwidth, tcount, awidth, otime, align, oldestGauge, spill, fstart} {
+		vec.DeletePartialMatch(prometheus.Labels{"location": name})
+	}
+	keepPerc.DeletePartialMatch(prometheus.Labels{"folder": name})
+	getTime.DeletePartialMatch(prometheus.Labels{"folder": name})
+	foundSlots.DeletePartialMatch(prometheus.Labels{"org": name})
+}
+
+// renameLocation renames the location in the org
+func renameLocation(org *pb.Organisation, name, newName string) (*pb.Location, error) {
+	if newName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Locations must be named")
+	}
+
+	var loc *pb.Location
+	for _, l := range org.GetLocations() {
+		if l.GetName() == newName {
+			return nil, status.Errorf(codes.AlreadyExists, "%v already exists", newName)
+		}
+		if l.GetName() == name {
+			loc = l
+		}
+	}
+	if loc == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find location %v", name)
+	}
+	loc.Name = newName
+	return loc, nil
+}
+
+// renameAudit moves the audit entries for a location to its new name, returning the number moved
+func renameAudit(audit *pb.AuditLog, name, newName string) int32 {
+	moved := int32(0)
+	for _, entry := range audit.GetEntries() {
+		if entry.GetLocation() == name {
+			entry.Location = newName
+			moved++
+		}
+	}
+	return moved
+}
+
+// moveAudit carries the audit trail over to the new name
+func (s *Server) moveAudit(ctx context.Context, name, newName string) (int32, error) {
+	auditLock.Lock()
+	defer auditLock.Unlock()
+
+	audit, err := s.readAudit(ctx)
+	if err != nil {
+		return 0, err
+	}
+	moved := renameAudit(audit, name, newName)
+	if moved == 0 {
+		return 0, nil
+	}
+	return moved, s.saveAudit(ctx, audit)
+}
+
+// reorderLocations moves the named locations to the front, in the given order
+func reorderLocations(org *pb.Organisation, names []string) error {
+	byName := make(map[string]*pb.Location)
+	for _, loc := range org.GetLocations() {
+		byName[loc.GetName()] = loc
+	}
+
+	moved := make(map[string]bool)
+	var locations []*pb.Location
+	for _, name := range names {
+		if moved[name] {
+			return status.Errorf(codes.InvalidArgument, "%v is listed twice", name)
+		}
+		if byName[name] == nil {
+			return status.Errorf(codes.NotFound, "Could not find location %v", name)
+		}
+		moved[name] = true
+		locations = append(locations, byName[name])
+	}
+	for _, loc := range org.GetLocations() {
+		if !moved[loc.GetName()] {
+			locations = append(locations, loc)
+		}
+	}
+
+	org.Locations = locations
+	return nil
+}
+
+// RenameLocation renames a location, carrying its audit trail over. The audit log is the only
+// stored history keyed on the location name; metric series restart under the new name.
+func (s *Server) RenameLocation(ctx context.Context, req *pb.RenameLocationRequest) (*pb.RenameLocationResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := renameLocation(org, req.GetName(), req.GetNewName())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.saveOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	deleteLocationMetrics(req.GetName())
+
+	// The rename has happened, so a failure to move the audit trail is reported rather than returned
+	resp := &pb.RenameLocationResponse{Location: loc}
+	resp.AuditEntries, err = s.moveAudit(ctx, req.GetName(), req.GetNewName())
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to move audit for %v: %v", req.GetName(), err))
+		resp.AuditError = err.Error()
+	}
+
+	return resp, nil
+}
+
+// ReorderLocations sets the order locations are listed in
+func (s *Server) ReorderLocations(ctx context.Context, req *pb.ReorderLocationsRequest) (*pb.ReorderLocationsResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reorderLocations(org, req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, loc := range org.GetLocations() {
+		names = append(names, loc.GetName())
+	}
+	return &pb.ReorderLocationsResponse{Names: names}, s.saveOrg(ctx, org)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestRenameLocation(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{{Name: "old"}, {Name: "other"}}}
+
+	loc, err := renameLocation(org, "old", "new")
+	if err != nil || loc.GetName() != "new" || org.GetLocations()[0].GetName() != "new" {
+		t.Fatalf("Bad rename: %v, %v", loc, err)
+	}
+
+	if _, err := renameLocation(org, "new", "other"); err == nil {
+		t.Errorf("Renamed onto an existing location")
+	}
+	if _, err := renameLocation(org, "missing", "fresh"); err == nil {
+		t.Errorf("Renamed a missing location")
+	}
+	if _, err := renameLocation(org, "new", ""); err == nil {
+		t.Errorf("Renamed to an empty name")
+	}
+}
+
+func TestRenameAudit(t *testing.T) {
+	audit := &pb.AuditLog{Entries: []*pb.AuditEntry{{Location: "old"}, {Location: "other"}, {Location: "old"}}}
+
+	if moved := renameAudit(audit, "old", "new"); moved != 2 {
+		t.Errorf("Bad move count: %v", moved)
+	}
+	if audit.GetEntries()[1].GetLocation() != "other" || audit.GetEntries()[2].GetLocation() != "new" {
+		t.Errorf("Bad audit: %v", audit)
+	}
+}
+
+func TestRenameMovesAudit(t *testing.T) {
+	s := getTestServer(".renameAudit")
+	ctx := context.Background()
+	s.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: &pb.OrganisationConfig{Locations: []*pb.Location{{Name: "audited"}}}})
+	s.saveAudit(ctx, &pb.AuditLog{Entries: []*pb.AuditEntry{{Location: "audited", Timestamp: time.Now().Unix()}}})
+
+	resp, err := s.RenameLocation(ctx, &pb.RenameLocationRequest{Name: "audited", NewName: "renamed"})
+	if err != nil || resp.GetAuditEntries() != 1 || resp.GetAuditError() != "" {
+		t.Fatalf("Bad rename: %v, %v", resp, err)
+	}
+
+	audit, err := s.QueryAudit(ctx, &pb.QueryAuditRequest{Location: "renamed"})
+	if err != nil || len(audit.GetEntries()) != 1 {
+		t.Errorf("Audit was not moved: %v, %v", audit, err)
+	}
+}
+
+func TestRenameDropsMetrics(t *testing.T) {
+	s := getTestServer(".renameMetrics")
+	ctx := context.Background()
+	s.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: &pb.OrganisationConfig{Locations: []*pb.Location{{Name: "metric"}}}})
+	otime.With(prometheus.Labels{"location": "metric"}).Set(10)
+
+	_, err := s.RenameLocation(ctx, &pb.RenameLocationRequest{Name: "metric", NewName: "renamed"})
+	if err != nil {
+		t.Fatalf("Unable to rename: %v", err)
+	}
+	if testutil.ToFloat64(otime.With(prometheus.Labels{"location": "metric"})) != 0 {
+		t.Errorf("Old metric was not deleted")
+	}
+}
+
+func TestReorderLocations(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}}
+	if err := reorderLocations(org, []string{"c", "a"}); err != nil {
+		t.Fatalf("Unable to reorder: %v", err)
+	}
+
+	var names []string
+	for _, loc := range org.GetLocations() {
+		names = append(names, loc.GetName())
+	}
+	if len(names) != 4 || names[0] != "c" || names[1] != "a" || names[2] != "b" || names[3] != "d" {
+		t.Errorf("Bad order: %v", names)
+	}
+
+	if err := reorderLocations(org, []string{"a", "a"}); err == nil {
+		t.Errorf("Duplicate names were accepted")
+	}
+	if err := reorderLocations(org, []string{"e"}); err == nil {
+		t.Errorf("Missing name was accepted")
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// applyPath copies a single masked field from src to dst, clearing it when src does not have it
+func applyPath(dst, src protoreflect.Message, path []string) {
+	field := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if len(path) > 1 {
+		applyPath(dst.Mutable(field).Message(), src.Get(field).Message(), path[1:])
+		return
+	}
+
+	if src.Has(field) {
+		dst.Set(field, src.Get(field))
+	} else {
+		dst.Clear(field)
+	}
+}
+
+// updateLocation applies the update request to the location
+func updateLocation(org *pb.Organisation, loc *pb.Location, req *pb.UpdateLocationRequest) error {
+	update := proto.Clone(req.GetUpdate()).(*pb.Location)
+	if update == nil {
+		update = &pb.Location{}
+	}
+
+	if req.GetUpdateMask() != nil {
+		if !req.GetUpdateMask().IsValid(loc) {
+			return status.Errorf(codes.InvalidArgument, "Bad update mask %v", req.GetUpdateMask().GetPaths())
+		}
+		for _, path := range req.GetUpdateMask().GetPaths() {
+			applyPath(loc.ProtoReflect(), update.ProtoReflect(), strings.Split(path, "."))
+		}
+	} else {
+		proto.Merge(loc, update)
+		// Slot definitions and size routes describe the whole unit, so replace rather than append
+		if len(update.GetSlotDefinitions()) > 0 {
+			loc.SlotDefinitions = update.GetSlotDefinitions()
+		}
+		if len(update.GetSizeRoutes()) > 0 {
+			loc.SizeRoutes = update.GetSizeRoutes()
+		}
+	}
+
+	// New folders are shelved after the existing ones unless the update says otherwise
+	for _, folder := range req.GetAddFolders() {
+		found := false
+		maxOrder := int32(-1)
+		for _, f := range loc.GetFolderIds() {
+			found = found || f == folder
+		}
+		for _, order := range loc.GetFolderOrder() {
+			if order > maxOrder {
+				maxOrder = order
+			}
+		}
+		if !found {
+			loc.FolderIds = append(loc.FolderIds, folder)
+		}
+		if loc.FolderOrder == nil {
+			loc.FolderOrder = make(map[int32]int32)
+		}
+		if _, ok := loc.FolderOrder[folder]; !ok {
+			loc.FolderOrder[folder] = maxOrder + 1
+		}
+	}
+	for _, folder := range req.GetRemoveFolders() {
+		var folders []int32
+		for _, f := range loc.GetFolderIds() {
+			if f != folder {
+				folders = append(folders, f)
+			}
+		}
+		loc.FolderIds = folders
+		delete(loc.FolderOrder, folder)
+		delete(loc.FolderSort, folder)
+		delete(loc.HardGap, folder)
+	}
+
+	for _, folder := range req.GetAddHardGaps() {
+		if loc.HardGap == nil {
+			loc.HardGap = make(map[int32]bool)
+		}
+		loc.HardGap[folder] = true
+	}
+	for _, folder := range req.GetRemoveHardGaps() {
+		delete(loc.HardGap, folder)
+	}
+
+	for folder, sort := range req.GetAddFolderSorts() {
+		if loc.FolderSort == nil {
+			loc.FolderSort = make(map[int32]pb.Location_Sorting)
+		}
+		loc.FolderSort[folder] = sort
+	}
+	for _, folder := range req.GetRemoveFolderSorts() {
+		delete(loc.FolderSort, folder)
+	}
+
+	// Slots placed in a physical unit take their width from it unless told otherwise
+	for _, def := range loc.GetSlotDefinitions() {
+		if def.GetWidth() == 0 && def.GetPhysical() != nil {
+			def.Width = findPhysicalSlot(org, def.GetPhysical()).GetWidth()
+		}
+	}
+
+	return nil
+}
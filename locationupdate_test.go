@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestUpdateLocationMaskClears(t *testing.T) {
+	loc := &pb.Location{Name: "test", NoAlert: true, SpillFolder: 12, Slots: 4, SlotsToSort: []int32{1, 2, 3}, Quota: &pb.Quota{NumOfSlots: 2, TotalWidth: 10}}
+	req := &pb.UpdateLocationRequest{
+		Update:     &pb.Location{Slots: 6, SlotsToSort: []int32{3}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"no_alert", "slots", "slots_to_sort", "quota.total_width"}},
+	}
+
+	if err := updateLocation(&pb.Organisation{}, loc, req); err != nil {
+		t.Fatalf("Unable to update: %v", err)
+	}
+	if loc.GetNoAlert() || loc.GetSlots() != 6 || len(loc.GetSlotsToSort()) != 1 {
+		t.Errorf("Mask was not applied: %v", loc)
+	}
+	if loc.GetSpillFolder() != 12 || loc.GetQuota().GetNumOfSlots() != 2 || loc.GetQuota().GetTotalWidth() != 0 {
+		t.Errorf("Unmasked fields changed: %v", loc)
+	}
+}
+
+func TestUpdateLocationBadMask(t *testing.T) {
+	req := &pb.UpdateLocationRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"not_a_field"}}}
+	if err := updateLocation(&pb.Organisation{}, &pb.Location{}, req); err == nil {
+		t.Errorf("Bad mask was accepted")
+	}
+}
+
+func TestUpdateLocationFolders(t *testing.T) {
+	loc := &pb.Location{
+		Name:        "test",
+		FolderIds:   []int32{1, 2},
+		FolderOrder: map[int32]int32{1: 0, 2: 1},
+		FolderSort:  map[int32]pb.Location_Sorting{1: pb.Location_BY_DATE_ADDED, 2: pb.Location_BY_DATE_ADDED},
+		HardGap:     map[int32]bool{2: true},
+	}
+	req := &pb.UpdateLocationRequest{
+		AddFolders:        []int32{1, 3},
+		RemoveFolders:     []int32{2},
+		AddHardGaps:       []int32{1},
+		AddFolderSorts:    map[int32]pb.Location_Sorting{3: pb.Location_BY_RELEASE_DATE},
+		RemoveFolderSorts: []int32{1},
+	}
+
+	if err := updateLocation(&pb.Organisation{}, loc, req); err != nil {
+		t.Fatalf("Unable to update: %v", err)
+	}
+	if len(loc.GetFolderIds()) != 2 || loc.GetFolderIds()[1] != 3 || loc.GetFolderOrder()[3] != 2 {
+		t.Errorf("Bad folders: %v", loc)
+	}
+	if _, ok := loc.GetFolderOrder()[2]; ok || loc.GetHardGap()[2] || !loc.GetHardGap()[1] {
+		t.Errorf("Removed folder left behind: %v", loc)
+	}
+	if _, ok := loc.GetFolderSort()[1]; ok || loc.GetFolderSort()[3] != pb.Location_BY_RELEASE_DATE {
+		t.Errorf("Bad folder sorts: %v", loc.GetFolderSort())
+	}
+	if len(validateOrganisation(&pb.Organisation{Locations: []*pb.Location{loc}})) != 0 {
+		t.Errorf("Update left the location invalid: %v", loc)
+	}
+}
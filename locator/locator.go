@@ -9,74 +9,76 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	pbrc "github.com/brotherlogic/recordcollection/proto"
 	pbro "github.com/brotherlogic/recordsorganiser/proto"
 )
 
-func getRecord(ctx context.Context, client pbrc.RecordCollectionServiceClient, id int64) (*pbrc.Record, error) {
-	val, err := client.GetRecord(ctx, &pbrc.GetRecordRequest{InstanceId: id})
-	if err != nil {
-		return nil, err
-	}
-	return val.GetRecord(), err
-}
-
-func getReleaseString(ctx context.Context, client pbrc.RecordCollectionServiceClient, loc *pbro.ReleasePlacement, showSleeve bool, brief bool) string {
-	rec, err := getRecord(ctx, client, loc.InstanceId)
-	if err != nil {
-		return fmt.Sprintf("%v", err)
-	}
-
+func getReleaseString(view *pbro.PlacementView, showSleeve bool, brief bool) string {
+	loc := view.GetPlacement()
 	if brief {
-		return fmt.Sprintf("%v", rec.GetRelease().GetTitle())
+		return fmt.Sprintf("%v", loc.GetTitle())
 	}
 
-	/*if rec.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_DIGITAL || rec.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_CD {
-		return ""
-	}*/
 	sleeve := ""
 	if showSleeve {
-		sleeve = fmt.Sprintf("%v", rec.GetMetadata().GetSleeve())
+		sleeve = view.GetSleeve()
 	}
-	return fmt.Sprintf("%v. ", rec.GetRelease().GetId()) + loc.Title + " " + fmt.Sprintf("%v", rec.GetMetadata().GetFiledUnder()) + " [" + strconv.Itoa(int(loc.InstanceId)) + "] - " + fmt.Sprintf("%v", rec.GetMetadata().GetCategory()) + " {" + fmt.Sprintf("%v", loc.GetDeterminedWidth()) + "} + " + fmt.Sprintf("%v", rec.GetMetadata().GetLastMoveTime()) + " [" + fmt.Sprintf("%v", rec.GetRelease().GetLabels()) + "]" + sleeve
+	return fmt.Sprintf("%v. ", view.GetReleaseId()) + view.GetArtist() + " - " + loc.Title + " " + view.GetFiled() + " [" + strconv.Itoa(int(loc.InstanceId)) + "] - " + view.GetCategory() + " {" + fmt.Sprintf("%v", loc.GetDeterminedWidth()) + "} [" + view.GetLabel() + " " + view.GetCatno() + "] " + view.GetFormat() + sleeve
 }
 
-func ReadableLocation(ctx context.Context, dial func(ctx context.Context, name string) (*grpc.ClientConn, error), id int64, brief bool) (string, error) {
-	conn, err := dial(ctx, "recordcollection")
-
-	if err != nil {
-		return "", err
+func ordinal(n int32) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
 	}
-	defer conn.Close()
-	client := pbrc.NewRecordCollectionServiceClient(conn)
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%v%v", n, suffix)
+}
 
-	conn2, err := dial(ctx, "recordsorganiser")
+// describePath gives the physical position of a record, e.g. living room, Kallax B, row 2, column 3, 14th record
+func describePath(path *pbro.PhysicalPath, position int32) string {
+	return fmt.Sprintf("%v, %v, row %v, column %v, %v record", path.GetRoom(), path.GetUnit(), path.GetRow(), path.GetColumn(), ordinal(position))
+}
+
+func ReadableLocation(ctx context.Context, dial func(ctx context.Context, name string) (*grpc.ClientConn, error), id int64, brief bool) (string, error) {
+	conn, err := dial(ctx, "recordsorganiser")
 	if err != nil {
 		return "", err
 	}
-	c := pbro.NewOrganiserServiceClient(conn2)
+	defer conn.Close()
+	c := pbro.NewOrganiserServiceClient(conn)
 
 	location, err := c.Locate(ctx, &pbro.LocateRequest{InstanceId: id})
 	if err != nil {
 		return "", err
 	}
+	if location.GetPlacement() == nil {
+		return "", status.Errorf(codes.NotFound, "Could not locate %v", id)
+	}
 
-	for i, r := range location.GetFoundLocation().GetReleasesLocation() {
-		str := ""
-		if r.GetInstanceId() == id {
-			str += fmt.Sprintf("Slot %v\n", r.GetSlot())
-			if i > 0 {
-				str += fmt.Sprintf("%v. %v\n", i-1, getReleaseString(ctx, client, location.GetFoundLocation().GetReleasesLocation()[i-1], false, brief))
-			}
-			str += fmt.Sprintf("%v. %v\n", i, getReleaseString(ctx, client, location.GetFoundLocation().GetReleasesLocation()[i], false, brief))
-			if i+1 >= len(location.GetFoundLocation().GetReleasesLocation()) {
-				str += fmt.Sprintf("%v. %v\n", i+1, "End of Slot")
-			} else {
-				str += fmt.Sprintf("%v. %v\n", i+1, getReleaseString(ctx, client, location.GetFoundLocation().GetReleasesLocation()[i+1], false, brief))
-			}
-			return str, nil
-		}
+	str := ""
+	if location.GetPath() != nil {
+		str += fmt.Sprintf("%v\n", describePath(location.GetPath(), location.GetPosition()))
+	} else {
+		str += fmt.Sprintf("Slot %v\n", location.GetPlacement().GetPlacement().GetSlot())
 	}
 
-	return "", status.Errorf(codes.NotFound, "Could not locate %v", id)
+	pos := int(location.GetPosition()) - 1
+	for i, view := range location.GetBefore() {
+		str += fmt.Sprintf("%v. %v\n", pos-len(location.GetBefore())+i, getReleaseString(view, false, brief))
+	}
+	str += fmt.Sprintf("%v. %v\n", pos, getReleaseString(location.GetPlacement(), false, brief))
+	for i, view := range location.GetAfter() {
+		str += fmt.Sprintf("%v. %v\n", pos+i+1, getReleaseString(view, false, brief))
+	}
+	if len(location.GetAfter()) == 0 {
+		str += fmt.Sprintf("%v. %v\n", pos+1, "End of Slot")
+	}
+	return str, nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/brotherlogic/goserver"
 	keystoreclient "github.com/brotherlogic/keystore/client"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pbd "github.com/brotherlogic/godiscogs/proto"
 	pbrc "github.com/brotherlogic/recordcollection/proto"
@@ -17,6 +19,31 @@ type testBridge struct {
 	widthMissing    bool
 	failGetReleases bool
 	failGetRecord   bool
+	keepers         bool
+}
+
+type testStore struct {
+	fail bool
+	data map[string][]byte
+}
+
+func (store *testStore) LoadData(ctx context.Context, key string, consensus float32) ([]byte, error) {
+	if store.fail {
+		return nil, fmt.Errorf("Built to fail")
+	}
+	data, ok := store.data[key]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "No data for %v", key)
+	}
+	return data, nil
+}
+
+func (store *testStore) SaveData(ctx context.Context, data []byte, key string, consensus float32) error {
+	if store.fail {
+		return fmt.Errorf("Built to fail")
+	}
+	store.data[key] = data
+	return nil
 }
 
 func (discogsBridge testBridge) GetIP(name string) (string, int) {
@@ -31,6 +58,9 @@ func (discogsBridge testBridge) getRecord(ctx context.Context, instanceID int64)
 	if discogsBridge.widthMissing {
 		metadata.SpineWidth = 0
 	}
+	if discogsBridge.keepers {
+		metadata.Keep = pbrc.ReleaseMetadata_KEEPER
+	}
 	switch instanceID {
 	case 1:
 		metadata.DateAdded = time.Now().Unix()
@@ -106,6 +136,13 @@ func (discogsBridge testBridge) getReleases(ctx context.Context, folders []int32
 	return ids, nil
 }
 
+func (discogsBridge testBridge) getAllReleases(ctx context.Context) ([]int64, error) {
+	if discogsBridge.failGetReleases {
+		return []int64{}, fmt.Errorf("Built to fail")
+	}
+	return []int64{1, 2}, nil
+}
+
 func (discogsBridge testBridge) getReleasesWithGoal(ctx context.Context, folders []int32) ([]*pbrc.Record, error) {
 	if discogsBridge.failGetReleases {
 		return []*pbrc.Record{}, fmt.Errorf("Built to fail")
@@ -183,13 +220,13 @@ func (discogsBridge testBridge) updateRecord(ctx context.Context, req *pbrc.Upda
 }
 
 func getTestServer(dir string) *Server {
-	testServer := &Server{GoServer: &goserver.GoServer{}, bridge: testBridge{}}
+	testServer := &Server{GoServer: &goserver.GoServer{}, bridge: testBridge{}, store: &testStore{data: make(map[string][]byte)}}
 	testServer.Register = testServer
 	testServer.GoServer.KSclient = *keystoreclient.GetTestClient(dir)
 	testServer.SkipLog = true
 	testServer.SkipIssue = true
 	org := &pb.Organisation{}
-	org.Extractors = append(org.Extractors, &pb.LabelExtractor{LabelId: 123, Extractor: "\\d\\d"})
+	org.Extractors = append(org.Extractors, &pb.LabelExtractor{LabelId: 123, Extractor: "(\\d\\d)"})
 	testServer.GoServer.KSclient.Save(context.Background(), KEY, org)
 	return testServer
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	pb "github.com/brotherlogic/recordsorganiser/proto"
 )
@@ -38,8 +40,112 @@ func (t *testgh) alert(ctx context.Context, r *pb.Location) error {
 
 func TestAddExtractor(t *testing.T) {
 	testServer := getTestServer(".addExtractor")
-	_, err := testServer.AddExtractor(context.Background(), &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 1234, Extractor: "hello"}})
+	_, err := testServer.AddExtractor(context.Background(), &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: 1234, Extractor: "hello(\\d+)"}})
 	if err != nil {
 		t.Errorf("Error adding label")
 	}
 }
+
+func TestLocateNeighbours(t *testing.T) {
+	loc := &pb.Location{Name: "test"}
+	for i := int64(1); i <= 5; i++ {
+		loc.ReleasesLocation = append(loc.ReleasesLocation, &pb.ReleasePlacement{InstanceId: i, Slot: 1, Index: int32(i - 1), DeterminedWidth: 2})
+	}
+	loc.ReleasesLocation = append(loc.ReleasesLocation, &pb.ReleasePlacement{InstanceId: 6, Slot: 2, DeterminedWidth: 2})
+
+	org := &pb.Organisation{Locations: []*pb.Location{loc}}
+	l, rp := locate(org, &pb.LocateRequest{InstanceId: 2})
+	resp := locateResponse(l, rp, &pb.SortingCache{}, 2)
+
+	if resp.GetPlacement().GetPlacement().GetInstanceId() != 2 || resp.GetPosition() != 2 {
+		t.Errorf("Bad placement: %v", resp)
+	}
+	if len(resp.GetBefore()) != 1 || resp.GetBefore()[0].GetPlacement().GetInstanceId() != 1 {
+		t.Errorf("Bad before: %v", resp.GetBefore())
+	}
+	if len(resp.GetAfter()) != 2 || resp.GetAfter()[1].GetPlacement().GetInstanceId() != 4 {
+		t.Errorf("Bad after: %v", resp.GetAfter())
+	}
+	if resp.GetDistanceFromStart() != 2 || resp.GetDistanceFromEnd() != 6 {
+		t.Errorf("Bad distances: %v, %v", resp.GetDistanceFromStart(), resp.GetDistanceFromEnd())
+	}
+
+	// Neighbours don't cross into the next slot
+	l, rp = locate(org, &pb.LocateRequest{InstanceId: 5})
+	resp = locateResponse(l, rp, &pb.SortingCache{}, 1)
+	if len(resp.GetAfter()) != 0 || len(resp.GetBefore()) != 1 {
+		t.Errorf("Bad end of slot: %v", resp)
+	}
+
+	resp = locateResponse(l, rp, &pb.SortingCache{}, 0)
+	if len(resp.GetAfter()) != 0 || len(resp.GetBefore()) != 0 || resp.GetPlacement().GetPlacement().GetInstanceId() != 5 {
+		t.Errorf("Asking for no neighbours returned some: %v", resp)
+	}
+	if resp.GetFoundLocation().GetName() != "test" || len(resp.GetFoundLocation().GetReleasesLocation()) != 0 {
+		t.Errorf("Found location should be lean: %v", resp.GetFoundLocation())
+	}
+	if len(loc.GetReleasesLocation()) != 6 {
+		t.Errorf("Stripping the response changed the org: %v", loc)
+	}
+}
+
+// countViews counts the placement rows held directly in the message
+func countViews(m protoreflect.Message) int {
+	count := 0
+	m.Range(func(field protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if field.Message() == nil || field.Message().FullName() != (&pb.PlacementView{}).ProtoReflect().Descriptor().FullName() {
+			return true
+		}
+		if field.IsList() {
+			count += val.List().Len()
+		} else {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func TestLocateOnlyReturnsNeighbours(t *testing.T) {
+	loc := &pb.Location{Name: "big"}
+	for i := int64(1); i <= 100; i++ {
+		loc.ReleasesLocation = append(loc.ReleasesLocation, &pb.ReleasePlacement{InstanceId: i, Slot: 1 + int32(i/50), Index: int32(i % 50)})
+	}
+
+	l, rp := locate(&pb.Organisation{Locations: []*pb.Location{loc}}, &pb.LocateRequest{InstanceId: 20})
+	resp := locateResponse(l, rp, &pb.SortingCache{}, 3)
+	if count := countViews(resp.ProtoReflect()); count != 7 {
+		t.Errorf("Response held %v rows, want the placement and three each side: %v", count, resp)
+	}
+	if len(resp.GetFoundLocation().GetReleasesLocation()) != 0 {
+		t.Errorf("Found location carried its placements: %v", resp.GetFoundLocation())
+	}
+}
+
+func TestLocateDefaultsToOneNeighbour(t *testing.T) {
+	s := getTestServer(".locateDefault")
+	ctx := context.Background()
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		t.Fatalf("Unable to read org: %v", err)
+	}
+	loc := &pb.Location{Name: "test"}
+	for i := int64(1); i <= 5; i++ {
+		loc.ReleasesLocation = append(loc.ReleasesLocation, &pb.ReleasePlacement{InstanceId: i, Slot: 1, Index: int32(i - 1)})
+	}
+	org.Locations = append(org.Locations, loc)
+	if err := s.saveOrg(ctx, org); err != nil {
+		t.Fatalf("Unable to save org: %v", err)
+	}
+
+	resp, err := s.Locate(ctx, &pb.LocateRequest{InstanceId: 3})
+	if err != nil || len(resp.GetBefore()) != 1 || len(resp.GetAfter()) != 1 {
+		t.Errorf("Unset neighbours should return one each side: %v, %v", resp, err)
+	}
+
+	resp, err = s.Locate(ctx, &pb.LocateRequest{InstanceId: 3, Neighbours: proto.Int32(0)})
+	if err != nil || len(resp.GetBefore()) != 0 || len(resp.GetAfter()) != 0 {
+		t.Errorf("Zero neighbours should return none: %v, %v", resp, err)
+	}
+}
@@ -2,24 +2,24 @@ package main
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"unicode"
 
 	"golang.org/x/net/context"
 
-	gd "github.com/brotherlogic/godiscogs"
 	rcpb "github.com/brotherlogic/recordcollection/proto"
 	pb "github.com/brotherlogic/recordsorganiser/proto"
 )
 
-func (s *Server) updateCache(ctx context.Context, rec *rcpb.Record) (*pb.SortingCache, error) {
+func (s *Server) updateCache(ctx context.Context, rec *rcpb.Record, extractors map[int32]*regexp.Regexp, rules *labelRules) (*pb.SortingCache, error) {
 
 	cache, err := s.loadCache(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	appendCache(cache, rec)
+	appendCache(cache, rec, extractors, rules)
 
 	return cache, s.saveCache(ctx, cache)
 }
@@ -34,8 +34,8 @@ func getEntry(c *pb.SortingCache, iid int64) *pb.CacheEntry {
 	return nil
 }
 
-func appendCache(cache *pb.SortingCache, rec *rcpb.Record) *pb.CacheEntry {
-	cacheEntry := buildCacheEntry(rec)
+func appendCache(cache *pb.SortingCache, rec *rcpb.Record, extractors map[int32]*regexp.Regexp, rules *labelRules) *pb.CacheEntry {
+	cacheEntry := buildCacheEntry(rec, extractors, rules)
 
 	var entries []*pb.CacheEntry
 	for _, entry := range cache.GetCache() {
@@ -81,25 +81,46 @@ func convertCatno(catnoIn string) string {
 	return strings.TrimSpace(ncat)
 }
 
-func buildCacheEntry(rec *rcpb.Record) *pb.CacheEntry {
-	label := gd.GetMainLabel(rec.GetRelease().GetLabels())
-	labelString := ""
-	seen := make(map[string]bool)
-	for _, label := range rec.GetRelease().GetLabels() {
-		if !seen[label.GetName()] {
-			labelString += label.GetName()
-			seen[label.GetName()] = true
-		}
+func artistString(rec *rcpb.Record) string {
+	var names []string
+	for _, artist := range rec.GetRelease().GetArtists() {
+		names = append(names, artist.GetName())
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatString describes the formats of a record, e.g. Vinyl, LP, Album
+func formatString(rec *rcpb.Record) string {
+	var formats []string
+	for _, format := range rec.GetRelease().GetFormats() {
+		formats = append(formats, strings.Join(append([]string{format.GetName()}, format.GetDescriptions()...), ", "))
 	}
+	return strings.Join(formats, " + ")
+}
+
+func buildCacheEntry(rec *rcpb.Record, extractors map[int32]*regexp.Regexp, rules *labelRules) *pb.CacheEntry {
+	label := rules.mainLabel(rec.GetRelease())
+	name := rules.canonical(label)
 	return &pb.CacheEntry{
 		InstanceId: rec.GetRelease().GetInstanceId(),
 		Width:      float64(rec.GetMetadata().GetRecordWidth()),
 		Filled:     rec.GetMetadata().GetFiledUnder().String(),
 		Folder:     rec.GetRelease().GetFolderId(),
-		MainLabel:  label.GetName(),
+		MainLabel:  name,
 		Category:   rec.GetMetadata().GetCategory().String(),
+
+		FormatQuantity: rec.GetRelease().GetFormatQuantity(),
+		Gatefold:       isGatefold(rec),
+		Boxset:         isBoxset(rec),
+		LabelId:        label.GetId(),
+		Sleeve:         int32(rec.GetMetadata().GetSleeve()),
+		SizeClass:      sizeClass(rec),
+		Artist:         artistString(rec),
+		Catno:          label.GetCatno(),
+		Format:         formatString(rec),
+		ReleaseId:      rec.GetRelease().GetId(),
+		LabelKey:       labelKey(rec.GetRelease(), extractors, rules),
 		Entry: map[string]string{
-			"BY_LABEL":      strings.ToLower(label.GetName() + "|" + convertCatno(label.GetCatno()) + "|" + rec.GetRelease().GetTitle()),
 			"BY_DATE_ADDED": strings.ToLower(fmt.Sprintf("%v", rec.GetMetadata().GetDateAdded()))},
 	}
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	gd "github.com/brotherlogic/godiscogs"
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func convertOverrides(overrides []*pb.LabelOverride) map[int64]*pb.LabelOverride {
+	m := make(map[int64]*pb.LabelOverride)
+	for _, override := range overrides {
+		m[override.GetInstanceId()] = override
+	}
+	return m
+}
+
+// mainLabel is the label the release is filed under; an override picks one of the release's
+// labels and can replace its catalogue number, otherwise this is the discogs main label
+func (r *labelRules) mainLabel(rel *pbgd.Release) *pbgd.Label {
+	label := gd.GetMainLabel(rel.GetLabels())
+	if r == nil {
+		return label
+	}
+	override, ok := r.overrides[rel.GetInstanceId()]
+	if !ok {
+		return label
+	}
+
+	for _, l := range rel.GetLabels() {
+		if override.GetLabelId() != 0 && l.GetId() == override.GetLabelId() {
+			label = l
+			break
+		}
+	}
+	if override.GetCatno() != "" {
+		label = proto.Clone(label).(*pbgd.Label)
+		label.Catno = override.GetCatno()
+	}
+	return label
+}
+
+// overrideProblem describes why the override can't apply to the record, if it can't
+func overrideProblem(override *pb.LabelOverride, rec *pbrc.Record) string {
+	if override.GetLabelId() == 0 {
+		if strings.TrimSpace(override.GetCatno()) == "" {
+			return "Overrides need a label or a catalogue number"
+		}
+		return ""
+	}
+	for _, label := range rec.GetRelease().GetLabels() {
+		if label.GetId() == override.GetLabelId() {
+			return ""
+		}
+	}
+	return "Record is not on that label"
+}
+
+// SetLabelOverride sets or removes the filing label for a record
+func (s *Server) SetLabelOverride(ctx context.Context, req *pb.SetLabelOverrideRequest) (*pb.SetLabelOverrideResponse, error) {
+	if req.GetOverride().GetInstanceId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Overrides need an instance id")
+	}
+
+	if !req.GetDelete() {
+		rec, err := s.bridge.getRecord(ctx, req.GetOverride().GetInstanceId())
+		if err != nil {
+			return nil, err
+		}
+		if problem := overrideProblem(req.GetOverride(), rec); problem != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", problem)
+		}
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var overrides []*pb.LabelOverride
+	for _, override := range org.GetLabelOverrides() {
+		if override.GetInstanceId() != req.GetOverride().GetInstanceId() {
+			overrides = append(overrides, override)
+		}
+	}
+	if !req.GetDelete() {
+		overrides = append(overrides, req.GetOverride())
+	}
+	sort.SliceStable(overrides, func(i, j int) bool { return overrides[i].GetInstanceId() < overrides[j].GetInstanceId() })
+	org.LabelOverrides = overrides
+
+	return &pb.SetLabelOverrideResponse{Overrides: org.GetLabelOverrides()}, s.saveOrg(ctx, org)
+}
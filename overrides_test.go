@@ -0,0 +1,111 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestOverrideFilesUnderSecondLabel(t *testing.T) {
+	coRelease := &pbrc.Record{Release: &pbd.Release{InstanceId: 1, Labels: []*pbd.Label{
+		{Id: 1, Name: "Warp", Catno: "WARP 50"},
+		{Id: 2, Name: "Bleep", Catno: "BLP 2"},
+	}}}
+	records := []*pbrc.Record{
+		aliasRecord(2, 2, "Bleep", "BLP 1"),
+		coRelease,
+		aliasRecord(3, 2, "Bleep", "BLP 3"),
+		aliasRecord(4, 1, "Warp", "WARP 40"),
+	}
+	rules := &labelRules{overrides: convertOverrides([]*pb.LabelOverride{{InstanceId: 1, LabelId: 2}})}
+
+	sortByLabel(records, nil, rules)
+	want := []int64{2, 1, 3, 4}
+	for i, rec := range records {
+		if rec.GetRelease().GetInstanceId() != want[i] {
+			t.Fatalf("Bad order at %v: %v, want %v", i, rec.GetRelease().GetInstanceId(), want)
+		}
+	}
+
+	cache := &pb.SortingCache{}
+	for _, rec := range records {
+		appendCache(cache, rec, nil, rules)
+	}
+	if entry := getEntry(cache, 1); entry.GetLabelId() != 2 || entry.GetMainLabel() != "Bleep" || entry.GetCatno() != "BLP 2" {
+		t.Errorf("Cache did not use the override: %v", entry)
+	}
+	if d := labelDivergences(records, cache, nil, rules); len(d) > 0 {
+		t.Errorf("Cached sort ignored the override: %v", d)
+	}
+}
+
+func TestOverrideCatno(t *testing.T) {
+	rel := &pbd.Release{InstanceId: 1, Labels: []*pbd.Label{{Id: 1, Name: "Warp", Catno: "WARP 50"}}}
+	rules := &labelRules{overrides: convertOverrides([]*pb.LabelOverride{{InstanceId: 1, Catno: "WARP 5"}})}
+
+	if label := rules.mainLabel(rel); label.GetId() != 1 || label.GetCatno() != "WARP 5" {
+		t.Errorf("Bad label: %v", label)
+	}
+	if rel.GetLabels()[0].GetCatno() != "WARP 50" {
+		t.Errorf("Override changed the release: %v", rel)
+	}
+
+	// An override for a label the release no longer has falls back to the main label
+	rules = &labelRules{overrides: convertOverrides([]*pb.LabelOverride{{InstanceId: 1, LabelId: 3}})}
+	if label := rules.mainLabel(rel); label.GetName() != "Warp" {
+		t.Errorf("Bad fallback: %v", label)
+	}
+}
+
+func TestOverrideEstimatesFromFilingLabel(t *testing.T) {
+	cache := &pb.SortingCache{}
+	for i := int64(1); i <= 3; i++ {
+		appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: i, FormatQuantity: 1, Labels: []*pbd.Label{{Id: 1, Name: "Warp"}}}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 2}}, nil, nil)
+		appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: i + 10, FormatQuantity: 1, Labels: []*pbd.Label{{Id: 2, Name: "Bleep"}}}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 4}}, nil, nil)
+	}
+	rules := &labelRules{overrides: convertOverrides([]*pb.LabelOverride{{InstanceId: 20, LabelId: 1}})}
+	est := newWidthEstimator(cache, defaultSleeveFactors(), rules)
+
+	rec := &pbrc.Record{Release: &pbd.Release{InstanceId: 20, FormatQuantity: 1, Labels: []*pbd.Label{{Id: 1, Name: "Warp"}, {Id: 2, Name: "Bleep"}}}}
+	if width, _ := est.estimate(rec); width != 2 {
+		t.Errorf("Estimate did not use the filing label: %v", width)
+	}
+}
+
+func TestSetLabelOverride(t *testing.T) {
+	s := getTestServer(".setOverride")
+	ctx := context.Background()
+
+	if _, err := s.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{LabelId: 2}}); err == nil {
+		t.Errorf("Override without an instance was accepted")
+	}
+	if _, err := s.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: 12}}); err == nil {
+		t.Errorf("Empty override was accepted")
+	}
+	if _, err := s.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: 12, LabelId: 2}}); err == nil {
+		t.Errorf("Override for a label the record is not on was accepted")
+	}
+
+	resp, err := s.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: 12, Catno: "WARP 5"}})
+	if err != nil || len(resp.GetOverrides()) != 1 || resp.GetOverrides()[0].GetCatno() != "WARP 5" {
+		t.Fatalf("Bad override: %v, %v", resp, err)
+	}
+
+	resp, err = s.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: 12}, Delete: true})
+	if err != nil || len(resp.GetOverrides()) != 0 {
+		t.Errorf("Bad delete: %v, %v", resp, err)
+	}
+}
+
+func TestSetLabelOverrideFailRecord(t *testing.T) {
+	s := getTestServer(".setOverrideFail")
+	s.bridge = testBridge{failGetRecord: true}
+
+	if _, err := s.SetLabelOverride(context.Background(), &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: 12, Catno: "WARP 5"}}); err == nil {
+		t.Errorf("Override was set without reading the record")
+	}
+}
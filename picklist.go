@@ -0,0 +1,68 @@
+package main
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// batchLocate groups the requested records by slot in the order they should be walked
+func batchLocate(org *pb.Organisation, cache *pb.SortingCache, ids []int64) *pb.BatchLocateResponse {
+	wanted := make(map[int64]bool)
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	entries := cacheIndex(cache)
+	resp := &pb.BatchLocateResponse{}
+	found := make(map[int64]bool)
+	for _, loc := range org.GetLocations() {
+		groups := make(map[int32]*pb.PickGroup)
+		var order []*pb.PickGroup
+		for _, rp := range loc.GetReleasesLocation() {
+			if !wanted[rp.GetInstanceId()] || found[rp.GetInstanceId()] {
+				continue
+			}
+			found[rp.GetInstanceId()] = true
+
+			group, ok := groups[rp.GetSlot()]
+			if !ok {
+				group = &pb.PickGroup{Location: loc.GetName(), Slot: rp.GetSlot(), Path: physicalPath(loc, rp.GetSlot())}
+				groups[rp.GetSlot()] = group
+				order = append(order, group)
+			}
+			group.Picks = append(group.Picks, placementView(loc, rp, entries[rp.GetInstanceId()]))
+		}
+
+		sort.SliceStable(order, func(i, j int) bool {
+			return order[i].GetSlot() < order[j].GetSlot()
+		})
+		for _, group := range order {
+			sort.SliceStable(group.Picks, func(i, j int) bool {
+				return group.Picks[i].GetPlacement().GetIndex() < group.Picks[j].GetPlacement().GetIndex()
+			})
+		}
+		resp.Groups = append(resp.Groups, order...)
+	}
+
+	for _, id := range ids {
+		if !found[id] {
+			resp.Missing = append(resp.Missing, id)
+			found[id] = true
+		}
+	}
+
+	return resp
+}
+
+// BatchLocate finds many records at once
+func (s *Server) BatchLocate(ctx context.Context, req *pb.BatchLocateRequest) (*pb.BatchLocateResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return batchLocate(org, s.displayCache(ctx), req.GetInstanceIds()), nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestBatchLocateWalkOrder(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{
+		{Name: "first", ReleasesLocation: []*pb.ReleasePlacement{
+			{InstanceId: 1, Slot: 1, Index: 0},
+			{InstanceId: 2, Slot: 1, Index: 1},
+			{InstanceId: 3, Slot: 2, Index: 0},
+		}},
+		{Name: "second", ReleasesLocation: []*pb.ReleasePlacement{
+			{InstanceId: 4, Slot: 1, Index: 0},
+		}},
+	}}
+
+	resp := batchLocate(org, &pb.SortingCache{}, []int64{4, 3, 2, 1, 99, 2})
+	if len(resp.GetGroups()) != 3 {
+		t.Fatalf("Bad groups: %v", resp)
+	}
+	if resp.GetGroups()[0].GetLocation() != "first" || resp.GetGroups()[0].GetSlot() != 1 || len(resp.GetGroups()[0].GetPicks()) != 2 {
+		t.Errorf("Bad first group: %v", resp.GetGroups()[0])
+	}
+	if resp.GetGroups()[0].GetPicks()[0].GetPlacement().GetInstanceId() != 1 {
+		t.Errorf("Picks are not in shelf order: %v", resp.GetGroups()[0])
+	}
+	if resp.GetGroups()[2].GetLocation() != "second" {
+		t.Errorf("Bad last group: %v", resp.GetGroups()[2])
+	}
+	if len(resp.GetMissing()) != 1 || resp.GetMissing()[0] != 99 {
+		t.Errorf("Bad missing: %v", resp.GetMissing())
+	}
+}
+
+func TestBatchLocateWithoutCache(t *testing.T) {
+	s := getTestServer(".batchLocateNoCache")
+	ctx := context.Background()
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		t.Fatalf("Unable to read org: %v", err)
+	}
+	org.Locations = append(org.Locations, &pb.Location{Name: "first", ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}}})
+	if err := s.saveOrg(ctx, org); err != nil {
+		t.Fatalf("Unable to save org: %v", err)
+	}
+
+	s.store = &testStore{fail: true}
+	resp, err := s.BatchLocate(ctx, &pb.BatchLocateRequest{InstanceIds: []int64{1}})
+	if err != nil || len(resp.GetGroups()) != 1 || len(resp.GetMissing()) != 0 {
+		t.Errorf("Batch failed without the cache: %v, %v", resp, err)
+	}
+}
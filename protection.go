@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// inLocation reports whether the record is still filed in one of the location's folders
+func inLocation(c *pb.Location, r *pbrc.Record) bool {
+	for _, folder := range c.GetFolderIds() {
+		if folder == r.GetRelease().GetFolderId() {
+			return true
+		}
+	}
+	return false
+}
+
+// protectionReason returns why a record cannot be picked for sale, or "" if it can be
+func protectionReason(rules *pb.ProtectionRules, r *pbrc.Record, now time.Time) string {
+	if r.GetMetadata().GetKeep() == pbrc.ReleaseMetadata_KEEPER {
+		return "keeper"
+	}
+
+	if r.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_DIGITAL {
+		return "digital only"
+	}
+
+	if r.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_BOX_UNKNOWN &&
+		r.GetMetadata().GetBoxState() != pbrc.ReleaseMetadata_OUT_OF_BOX {
+		return "in the box"
+	}
+
+	if r.GetMetadata().GetNeedsGramUpdate() {
+		return "needs gram update"
+	}
+
+	for _, id := range rules.GetPinnedIds() {
+		if id == r.GetRelease().GetInstanceId() {
+			return "pinned"
+		}
+	}
+
+	if rules.GetMinOwnershipSeconds() > 0 && r.GetMetadata().GetDateAdded() > 0 &&
+		now.Unix()-r.GetMetadata().GetDateAdded() < rules.GetMinOwnershipSeconds() {
+		return "recently added"
+	}
+
+	return ""
+}
+
+// sellCandidates filters records down to those that can be sold, preserving order; records
+// which have left the location are dropped rather than counted as protected
+func sellCandidates(rules *pb.ProtectionRules, c *pb.Location, records []*pbrc.Record) ([]*pbrc.Record, map[string]int) {
+	var candidates []*pbrc.Record
+	protected := make(map[string]int)
+	now := time.Now()
+	for _, r := range records {
+		if !inLocation(c, r) {
+			continue
+		}
+		if reason := protectionReason(rules, r, now); reason != "" {
+			protected[reason]++
+		} else {
+			candidates = append(candidates, r)
+		}
+	}
+	return candidates, protected
+}
+
+// nothingSellable raises a single alert covering every protected record and
+// returns the error signalling that the quota could not be enforced
+func (s *Server) nothingSellable(ctx context.Context, c *pb.Location, needed int, protected map[string]int) error {
+	// Nothing left on the shelf is protected, the records over quota have already gone
+	if len(protected) == 0 {
+		return nil
+	}
+
+	var reasons []string
+	for reason, count := range protected {
+		reasons = append(reasons, fmt.Sprintf("%v: %v", reason, count))
+	}
+	sort.Strings(reasons)
+
+	body := fmt.Sprintf("%v is over quota and needs %v more records to sell but every candidate is protected (%v)", c.GetName(), needed, strings.Join(reasons, ", "))
+	s.CtxLog(ctx, body)
+	s.RaiseIssue(fmt.Sprintf("Nothing sellable in %v", c.GetName()), body)
+
+	return status.Errorf(codes.FailedPrecondition, "nothing sellable in %v", c.GetName())
+}
+
+// UpdateProtection adjusts the rules which protect records from sale
+func (s *Server) UpdateProtection(ctx context.Context, req *pb.UpdateProtectionRequest) (*pb.UpdateProtectionResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if org.GetProtection() == nil {
+		org.Protection = &pb.ProtectionRules{}
+	}
+	rules := org.GetProtection()
+
+	remove := make(map[int64]bool)
+	for _, id := range req.GetUnpin() {
+		remove[id] = true
+	}
+	var pinned []int64
+	seen := make(map[int64]bool)
+	for _, id := range append(rules.GetPinnedIds(), req.GetPin()...) {
+		if !remove[id] && !seen[id] {
+			pinned = append(pinned, id)
+			seen[id] = true
+		}
+	}
+	rules.PinnedIds = pinned
+
+	if req.GetMinOwnershipSeconds() > 0 {
+		rules.MinOwnershipSeconds = req.GetMinOwnershipSeconds()
+	} else if req.GetMinOwnershipSeconds() < 0 {
+		rules.MinOwnershipSeconds = 0
+	}
+
+	return &pb.UpdateProtectionResponse{Rules: rules}, s.saveOrg(ctx, org)
+}
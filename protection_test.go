@@ -0,0 +1,155 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbgd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestProtectionReasons(t *testing.T) {
+	rules := &pb.ProtectionRules{PinnedIds: []int64{5}, MinOwnershipSeconds: 60 * 60}
+	now := time.Now()
+
+	tests := []struct {
+		record *pbrc.Record
+		reason string
+	}{
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 1, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{Keep: pbrc.ReleaseMetadata_KEEPER}}, "keeper"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 2, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{FiledUnder: pbrc.ReleaseMetadata_FILE_DIGITAL}}, "digital only"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 3, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{BoxState: pbrc.ReleaseMetadata_IN_THE_BOX}}, "in the box"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 4, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{NeedsGramUpdate: true}}, "needs gram update"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 5, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{}}, "pinned"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 6, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{DateAdded: now.Unix() - 10}}, "recently added"},
+		{&pbrc.Record{Release: &pbgd.Release{InstanceId: 8, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{DateAdded: now.Unix() - 60*60*2}}, ""},
+	}
+
+	for _, tt := range tests {
+		if reason := protectionReason(rules, tt.record, now); reason != tt.reason {
+			t.Errorf("Bad protection for %v: got %q, want %q", tt.record.GetRelease().GetInstanceId(), reason, tt.reason)
+		}
+	}
+}
+
+func TestSellCandidatesDropsMovedRecords(t *testing.T) {
+	loc := &pb.Location{FolderIds: []int32{12}}
+	records := []*pbrc.Record{
+		{Release: &pbgd.Release{InstanceId: 1, FolderId: 12}, Metadata: &pbrc.ReleaseMetadata{}},
+		{Release: &pbgd.Release{InstanceId: 2, FolderId: 13}, Metadata: &pbrc.ReleaseMetadata{Keep: pbrc.ReleaseMetadata_KEEPER}},
+		{Release: &pbgd.Release{InstanceId: 3, FolderId: 13}, Metadata: &pbrc.ReleaseMetadata{}},
+	}
+
+	candidates, protected := sellCandidates(&pb.ProtectionRules{}, loc, records)
+	if len(candidates) != 1 || candidates[0].GetRelease().GetInstanceId() != 1 || len(protected) != 0 {
+		t.Errorf("Bad candidates: %v, %v", candidates, protected)
+	}
+}
+
+func TestSlotQuotaIgnoresMovedRecords(t *testing.T) {
+	s := getTestServer(".slotQuotaMoved")
+	s.bridge = testBridge{keepers: true}
+
+	loc := &pb.Location{
+		Name:             "moved",
+		FolderIds:        []int32{12},
+		Quota:            &pb.Quota{QuotaType: &pb.Quota_Slots{Slots: 1}},
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}, {InstanceId: 2, Slot: 2}},
+	}
+
+	// Every record has left the location, so there is nothing to sell and nothing to report
+	if err := s.processSlotQuota(context.Background(), loc, &pb.ProtectionRules{}); err != nil {
+		t.Errorf("Moved records blocked the quota: %v", err)
+	}
+	if s.IssueCount != 0 {
+		t.Errorf("Moved records raised %v issues", s.IssueCount)
+	}
+}
+
+func TestSlotQuotaRaisesOneIssue(t *testing.T) {
+	s := getTestServer(".slotQuotaOneIssue")
+	s.bridge = testBridge{keepers: true}
+
+	loc := &pb.Location{
+		Name:             "stocked",
+		FolderIds:        []int32{0},
+		Quota:            &pb.Quota{QuotaType: &pb.Quota_Slots{Slots: 1}},
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}, {InstanceId: 2, Slot: 2}},
+	}
+
+	// The records all score the same and are all keepers
+	err := s.processSlotQuota(context.Background(), loc, &pb.ProtectionRules{})
+	if status.Convert(err).Code() != codes.FailedPrecondition || s.IssueCount != 1 {
+		t.Errorf("Should have raised a single issue: %v, %v", err, s.IssueCount)
+	}
+}
+
+func TestWidthQuotaSkipsKeepers(t *testing.T) {
+	s := getTestServer(".widthQuotaKeepers")
+	s.bridge = testBridge{keepers: true}
+
+	loc := &pb.Location{
+		Name:      "keepers",
+		FolderIds: []int32{0},
+		Quota:     &pb.Quota{QuotaType: &pb.Quota_AbsoluteWidth{AbsoluteWidth: 10}},
+		ReleasesLocation: []*pb.ReleasePlacement{
+			{InstanceId: 1, Slot: 1, DeterminedWidth: 8},
+			{InstanceId: 2, Slot: 1, DeterminedWidth: 8},
+		},
+	}
+
+	err := s.processAbsoluteWidthQuota(context.Background(), loc, &pb.ProtectionRules{})
+	if status.Convert(err).Code() != codes.FailedPrecondition {
+		t.Errorf("Over quota keepers should have nothing sellable: %v", err)
+	}
+
+	if s.IssueCount != 1 {
+		t.Errorf("Should have raised a single issue: %v", s.IssueCount)
+	}
+}
+
+func TestWidthQuotaEstimatesUnmeasured(t *testing.T) {
+	s := getTestServer(".widthQuotaEstimates")
+	s.bridge = testBridge{keepers: true}
+
+	cache := &pb.SortingCache{}
+	for i := int64(1); i <= 3; i++ {
+		appendCache(cache, &pbrc.Record{Release: &pbgd.Release{InstanceId: i + 100}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 5}}, nil, nil)
+	}
+	loc := &pb.Location{
+		Name:             "unmeasured",
+		FolderIds:        []int32{0},
+		Slots:            1,
+		Quota:            &pb.Quota{TotalWidth: 8},
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}, {InstanceId: 2, Slot: 1}},
+	}
+
+	// Neither record has a width, but the estimate puts the slot over
+	err := s.processWidthQuota(context.Background(), loc, &pb.ProtectionRules{}, newWidthEstimator(cache, defaultSleeveFactors(), nil))
+	if status.Convert(err).Code() != codes.FailedPrecondition {
+		t.Errorf("Estimated widths should count toward the quota: %v", err)
+	}
+}
+
+func TestUpdateProtection(t *testing.T) {
+	s := getTestServer(".updateProtection")
+
+	_, err := s.UpdateProtection(context.Background(), &pb.UpdateProtectionRequest{Pin: []int64{1, 2, 2}, MinOwnershipSeconds: 100})
+	if err != nil {
+		t.Fatalf("Unable to update protection: %v", err)
+	}
+
+	resp, err := s.UpdateProtection(context.Background(), &pb.UpdateProtectionRequest{Unpin: []int64{1}, MinOwnershipSeconds: -1})
+	if err != nil {
+		t.Fatalf("Unable to update protection: %v", err)
+	}
+
+	if len(resp.GetRules().GetPinnedIds()) != 1 || resp.GetRules().GetPinnedIds()[0] != 2 || resp.GetRules().GetMinOwnershipSeconds() != 0 {
+		t.Errorf("Bad protection rules: %v", resp.GetRules())
+	}
+}
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -79,7 +80,7 @@ func (x Location_Sorting) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Location_Sorting.Descriptor instead.
 func (Location_Sorting) EnumDescriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{7, 0}
+	return file_organise_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type Location_Checking int32
@@ -125,7 +126,7 @@ func (x Location_Checking) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Location_Checking.Descriptor instead.
 func (Location_Checking) EnumDescriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{7, 1}
+	return file_organise_proto_rawDescGZIP(), []int{15, 1}
 }
 
 type Location_InPlay int32
@@ -174,7 +175,7 @@ func (x Location_InPlay) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Location_InPlay.Descriptor instead.
 func (Location_InPlay) EnumDescriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{7, 2}
+	return file_organise_proto_rawDescGZIP(), []int{15, 2}
 }
 
 type Location_MediaType int32
@@ -226,7 +227,56 @@ func (x Location_MediaType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Location_MediaType.Descriptor instead.
 func (Location_MediaType) EnumDescriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{7, 3}
+	return file_organise_proto_rawDescGZIP(), []int{15, 3}
+}
+
+type ExportOrganisationRequest_Format int32
+
+const (
+	ExportOrganisationRequest_CSV      ExportOrganisationRequest_Format = 0
+	ExportOrganisationRequest_JSON     ExportOrganisationRequest_Format = 1
+	ExportOrganisationRequest_MARKDOWN ExportOrganisationRequest_Format = 2
+)
+
+// Enum value maps for ExportOrganisationRequest_Format.
+var (
+	ExportOrganisationRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+		2: "MARKDOWN",
+	}
+	ExportOrganisationRequest_Format_value = map[string]int32{
+		"CSV":      0,
+		"JSON":     1,
+		"MARKDOWN": 2,
+	}
+)
+
+func (x ExportOrganisationRequest_Format) Enum() *ExportOrganisationRequest_Format {
+	p := new(ExportOrganisationRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportOrganisationRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportOrganisationRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_organise_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportOrganisationRequest_Format) Type() protoreflect.EnumType {
+	return &file_organise_proto_enumTypes[4]
+}
+
+func (x ExportOrganisationRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportOrganisationRequest_Format.Descriptor instead.
+func (ExportOrganisationRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{62, 0}
 }
 
 type Empty struct {
@@ -342,6 +392,21 @@ type CacheEntry struct {
 	Folder     int32             `protobuf:"varint,5,opt,name=folder,proto3" json:"folder,omitempty"`
 	MainLabel  string            `protobuf:"bytes,7,opt,name=mainLabel,proto3" json:"mainLabel,omitempty"`
 	Category   string            `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	// Features used to estimate width
+	FormatQuantity int32 `protobuf:"varint,9,opt,name=format_quantity,json=formatQuantity,proto3" json:"format_quantity,omitempty"`
+	Gatefold       bool  `protobuf:"varint,10,opt,name=gatefold,proto3" json:"gatefold,omitempty"`
+	Boxset         bool  `protobuf:"varint,11,opt,name=boxset,proto3" json:"boxset,omitempty"`
+	LabelId        int32 `protobuf:"varint,12,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	Sleeve         int32 `protobuf:"varint,13,opt,name=sleeve,proto3" json:"sleeve,omitempty"`
+	// The physical size of the record (e.g. 7, 10, 12, CD)
+	SizeClass string `protobuf:"bytes,14,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	// Display details so callers don't need to go to recordcollection
+	Artist    string `protobuf:"bytes,15,opt,name=artist,proto3" json:"artist,omitempty"`
+	Catno     string `protobuf:"bytes,16,opt,name=catno,proto3" json:"catno,omitempty"`
+	Format    string `protobuf:"bytes,17,opt,name=format,proto3" json:"format,omitempty"`
+	ReleaseId int32  `protobuf:"varint,18,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// The key used when sorting by label and catalogue number
+	LabelKey *LabelSortKey `protobuf:"bytes,19,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
 }
 
 func (x *CacheEntry) Reset() {
@@ -425,6 +490,175 @@ func (x *CacheEntry) GetCategory() string {
 	return ""
 }
 
+func (x *CacheEntry) GetFormatQuantity() int32 {
+	if x != nil {
+		return x.FormatQuantity
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetGatefold() bool {
+	if x != nil {
+		return x.Gatefold
+	}
+	return false
+}
+
+func (x *CacheEntry) GetBoxset() bool {
+	if x != nil {
+		return x.Boxset
+	}
+	return false
+}
+
+func (x *CacheEntry) GetLabelId() int32 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetSleeve() int32 {
+	if x != nil {
+		return x.Sleeve
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCatno() string {
+	if x != nil {
+		return x.Catno
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetReleaseId() int32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetLabelKey() *LabelSortKey {
+	if x != nil {
+		return x.LabelKey
+	}
+	return nil
+}
+
+type LabelSortKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Records without a label sort first
+	Labelled bool `protobuf:"varint,1,opt,name=labelled,proto3" json:"labelled,omitempty"`
+	// The lower case main label name
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The catalogue number split into runs of letters and digits
+	Parts []string `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	// The parts picked out by the label extractor, if there is one and it matched
+	Extracted []string `protobuf:"bytes,4,rep,name=extracted,proto3" json:"extracted,omitempty"`
+	Title     string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// The lower case label family, the label itself if it has none
+	Family string `protobuf:"bytes,6,opt,name=family,proto3" json:"family,omitempty"`
+}
+
+func (x *LabelSortKey) Reset() {
+	*x = LabelSortKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelSortKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSortKey) ProtoMessage() {}
+
+func (x *LabelSortKey) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSortKey.ProtoReflect.Descriptor instead.
+func (*LabelSortKey) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LabelSortKey) GetLabelled() bool {
+	if x != nil {
+		return x.Labelled
+	}
+	return false
+}
+
+func (x *LabelSortKey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LabelSortKey) GetParts() []string {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *LabelSortKey) GetExtracted() []string {
+	if x != nil {
+		return x.Extracted
+	}
+	return nil
+}
+
+func (x *LabelSortKey) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LabelSortKey) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
 type SortingCache struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -436,7 +670,7 @@ type SortingCache struct {
 func (x *SortingCache) Reset() {
 	*x = SortingCache{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[3]
+		mi := &file_organise_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -449,7 +683,7 @@ func (x *SortingCache) String() string {
 func (*SortingCache) ProtoMessage() {}
 
 func (x *SortingCache) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[3]
+	mi := &file_organise_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +696,7 @@ func (x *SortingCache) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortingCache.ProtoReflect.Descriptor instead.
 func (*SortingCache) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{3}
+	return file_organise_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SortingCache) GetCache() []*CacheEntry {
@@ -486,7 +720,7 @@ type LabelExtractor struct {
 func (x *LabelExtractor) Reset() {
 	*x = LabelExtractor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[4]
+		mi := &file_organise_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -499,7 +733,7 @@ func (x *LabelExtractor) String() string {
 func (*LabelExtractor) ProtoMessage() {}
 
 func (x *LabelExtractor) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[4]
+	mi := &file_organise_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +746,7 @@ func (x *LabelExtractor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LabelExtractor.ProtoReflect.Descriptor instead.
 func (*LabelExtractor) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{4}
+	return file_organise_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LabelExtractor) GetLabelId() int32 {
@@ -544,12 +778,18 @@ type ReleasePlacement struct {
 	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
 	// Determined width of this release
 	DeterminedWidth float32 `protobuf:"fixed32,5,opt,name=determined_width,json=determinedWidth,proto3" json:"determined_width,omitempty"`
+	// Set if the width was estimated rather than measured
+	EstimatedWidth  bool    `protobuf:"varint,6,opt,name=estimated_width,json=estimatedWidth,proto3" json:"estimated_width,omitempty"`
+	WidthConfidence float32 `protobuf:"fixed32,7,opt,name=width_confidence,json=widthConfidence,proto3" json:"width_confidence,omitempty"`
+	// The physical size of the record and the part of the location it was routed to
+	SizeClass   string `protobuf:"bytes,8,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	SubLocation string `protobuf:"bytes,9,opt,name=sub_location,json=subLocation,proto3" json:"sub_location,omitempty"`
 }
 
 func (x *ReleasePlacement) Reset() {
 	*x = ReleasePlacement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[5]
+		mi := &file_organise_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +802,7 @@ func (x *ReleasePlacement) String() string {
 func (*ReleasePlacement) ProtoMessage() {}
 
 func (x *ReleasePlacement) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[5]
+	mi := &file_organise_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +815,7 @@ func (x *ReleasePlacement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleasePlacement.ProtoReflect.Descriptor instead.
 func (*ReleasePlacement) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{5}
+	return file_organise_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReleasePlacement) GetInstanceId() int64 {
@@ -613,6 +853,34 @@ func (x *ReleasePlacement) GetDeterminedWidth() float32 {
 	return 0
 }
 
+func (x *ReleasePlacement) GetEstimatedWidth() bool {
+	if x != nil {
+		return x.EstimatedWidth
+	}
+	return false
+}
+
+func (x *ReleasePlacement) GetWidthConfidence() float32 {
+	if x != nil {
+		return x.WidthConfidence
+	}
+	return 0
+}
+
+func (x *ReleasePlacement) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+func (x *ReleasePlacement) GetSubLocation() string {
+	if x != nil {
+		return x.SubLocation
+	}
+	return ""
+}
+
 type Quota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -632,7 +900,7 @@ type Quota struct {
 func (x *Quota) Reset() {
 	*x = Quota{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[6]
+		mi := &file_organise_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +913,7 @@ func (x *Quota) String() string {
 func (*Quota) ProtoMessage() {}
 
 func (x *Quota) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[6]
+	mi := &file_organise_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +926,7 @@ func (x *Quota) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Quota.ProtoReflect.Descriptor instead.
 func (*Quota) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{6}
+	return file_organise_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Quota) GetNumOfSlots() int32 {
@@ -728,67 +996,38 @@ func (*Quota_Width) isQuota_QuotaType() {}
 
 func (*Quota_AbsoluteWidth) isQuota_QuotaType() {}
 
-type Location struct {
+type SlotDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the location
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The number of units within the location
-	Slots int32 `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
-	// The folder ids that are stored in this location
-	FolderIds   []int32                    `protobuf:"varint,3,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
-	FolderOrder map[int32]int32            `protobuf:"bytes,19,rep,name=folder_order,json=folderOrder,proto3" json:"folder_order,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	FolderSort  map[int32]Location_Sorting `protobuf:"bytes,20,rep,name=folder_sort,json=folderSort,proto3" json:"folder_sort,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=recordsorganiser.Location_Sorting"`
-	HardGap     map[int32]bool             `protobuf:"bytes,21,rep,name=hard_gap,json=hardGap,proto3" json:"hard_gap,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// The placement of releases in the folder
-	ReleasesLocation []*ReleasePlacement `protobuf:"bytes,4,rep,name=releases_location,json=releasesLocation,proto3" json:"releases_location,omitempty"`
-	Sort             Location_Sorting    `protobuf:"varint,5,opt,name=sort,proto3,enum=recordsorganiser.Location_Sorting" json:"sort,omitempty"`
-	// The timestamp of this given location / arrangement
-	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The allowed quota for this location, if any
-	Quota *Quota `protobuf:"bytes,7,opt,name=quota,proto3" json:"quota,omitempty"`
-	// The type of format we expect in this location
-	ExpectedFormat string `protobuf:"bytes,8,opt,name=expected_format,json=expectedFormat,proto3" json:"expected_format,omitempty"`
-	// The type of label we don't expect in this location
-	UnexpectedLabel string `protobuf:"bytes,9,opt,name=unexpected_label,json=unexpectedLabel,proto3" json:"unexpected_label,omitempty"`
-	// Don't send an alert when there are quota problems
-	NoAlert bool `protobuf:"varint,10,opt,name=no_alert,json=noAlert,proto3" json:"no_alert,omitempty"`
-	// Folder to move to when this is over quota
-	SpillFolder int32 `protobuf:"varint,11,opt,name=spill_folder,json=spillFolder,proto3" json:"spill_folder,omitempty"`
-	// Quota Check opt out - if set no alerts on quota
-	OptOutQuotaChecks bool `protobuf:"varint,12,opt,name=opt_out_quota_checks,json=optOutQuotaChecks,proto3" json:"opt_out_quota_checks,omitempty"`
-	// Time to include reorgs, if < 0 we don't reorg
-	ReorgTime      int64              `protobuf:"varint,13,opt,name=reorg_time,json=reorgTime,proto3" json:"reorg_time,omitempty"`
-	LastReorg      int64              `protobuf:"varint,14,opt,name=last_reorg,json=lastReorg,proto3" json:"last_reorg,omitempty"`
-	Checking       Location_Checking  `protobuf:"varint,15,opt,name=checking,proto3,enum=recordsorganiser.Location_Checking" json:"checking,omitempty"`
-	OverQuotaTime  int64              `protobuf:"varint,16,opt,name=over_quota_time,json=overQuotaTime,proto3" json:"over_quota_time,omitempty"`
-	InPlay         Location_InPlay    `protobuf:"varint,17,opt,name=in_play,json=inPlay,proto3,enum=recordsorganiser.Location_InPlay" json:"in_play,omitempty"`
-	MediaType      Location_MediaType `protobuf:"varint,18,opt,name=media_type,json=mediaType,proto3,enum=recordsorganiser.Location_MediaType" json:"media_type,omitempty"`
-	AllowAdjust    bool               `protobuf:"varint,22,opt,name=allow_adjust,json=allowAdjust,proto3" json:"allow_adjust,omitempty"`
-	CombineSimilar bool               `protobuf:"varint,23,opt,name=combine_similar,json=combineSimilar,proto3" json:"combine_similar,omitempty"`
-	SlotsToSort    []int32            `protobuf:"varint,24,rep,packed,name=slots_to_sort,json=slotsToSort,proto3" json:"slots_to_sort,omitempty"`
-	LastSort       int32              `protobuf:"varint,25,opt,name=last_sort,json=lastSort,proto3" json:"last_sort,omitempty"`
+	// The usable width of the slot
+	Width float32 `protobuf:"fixed32,1,opt,name=width,proto3" json:"width,omitempty"`
+	// The size of record this slot can take (e.g. 7 for a short top row)
+	HeightClass string `protobuf:"bytes,2,opt,name=height_class,json=heightClass,proto3" json:"height_class,omitempty"`
+	// A human readable label for the slot
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// Where this slot physically sits
+	Physical *PhysicalPath `protobuf:"bytes,4,opt,name=physical,proto3" json:"physical,omitempty"`
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
+func (x *SlotDefinition) Reset() {
+	*x = SlotDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[7]
+		mi := &file_organise_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Location) String() string {
+func (x *SlotDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*SlotDefinition) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[7]
+func (x *SlotDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,218 +1038,187 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Location) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use SlotDefinition.ProtoReflect.Descriptor instead.
+func (*SlotDefinition) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Location) GetSlots() int32 {
+func (x *SlotDefinition) GetWidth() float32 {
 	if x != nil {
-		return x.Slots
+		return x.Width
 	}
 	return 0
 }
 
-func (x *Location) GetFolderIds() []int32 {
+func (x *SlotDefinition) GetHeightClass() string {
 	if x != nil {
-		return x.FolderIds
+		return x.HeightClass
 	}
-	return nil
+	return ""
 }
 
-func (x *Location) GetFolderOrder() map[int32]int32 {
+func (x *SlotDefinition) GetLabel() string {
 	if x != nil {
-		return x.FolderOrder
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *Location) GetFolderSort() map[int32]Location_Sorting {
+func (x *SlotDefinition) GetPhysical() *PhysicalPath {
 	if x != nil {
-		return x.FolderSort
+		return x.Physical
 	}
 	return nil
 }
 
-func (x *Location) GetHardGap() map[int32]bool {
-	if x != nil {
-		return x.HardGap
-	}
-	return nil
-}
-
-func (x *Location) GetReleasesLocation() []*ReleasePlacement {
-	if x != nil {
-		return x.ReleasesLocation
-	}
-	return nil
-}
+type PhysicalSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Location) GetSort() Location_Sorting {
-	if x != nil {
-		return x.Sort
-	}
-	return Location_BY_LABEL_CATNO
+	// The column of this slot within the shelf, counted from 1
+	Column int32 `protobuf:"varint,1,opt,name=column,proto3" json:"column,omitempty"`
+	// The dimensions of the slot
+	Width  float32 `protobuf:"fixed32,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height float32 `protobuf:"fixed32,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *Location) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
+func (x *PhysicalSlot) Reset() {
+	*x = PhysicalSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Location) GetQuota() *Quota {
-	if x != nil {
-		return x.Quota
-	}
-	return nil
+func (x *PhysicalSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Location) GetExpectedFormat() string {
-	if x != nil {
-		return x.ExpectedFormat
-	}
-	return ""
-}
+func (*PhysicalSlot) ProtoMessage() {}
 
-func (x *Location) GetUnexpectedLabel() string {
-	if x != nil {
-		return x.UnexpectedLabel
+func (x *PhysicalSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Location) GetNoAlert() bool {
-	if x != nil {
-		return x.NoAlert
-	}
-	return false
+// Deprecated: Use PhysicalSlot.ProtoReflect.Descriptor instead.
+func (*PhysicalSlot) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Location) GetSpillFolder() int32 {
+func (x *PhysicalSlot) GetColumn() int32 {
 	if x != nil {
-		return x.SpillFolder
+		return x.Column
 	}
 	return 0
 }
 
-func (x *Location) GetOptOutQuotaChecks() bool {
-	if x != nil {
-		return x.OptOutQuotaChecks
-	}
-	return false
-}
-
-func (x *Location) GetReorgTime() int64 {
+func (x *PhysicalSlot) GetWidth() float32 {
 	if x != nil {
-		return x.ReorgTime
+		return x.Width
 	}
 	return 0
 }
 
-func (x *Location) GetLastReorg() int64 {
+func (x *PhysicalSlot) GetHeight() float32 {
 	if x != nil {
-		return x.LastReorg
+		return x.Height
 	}
 	return 0
 }
 
-func (x *Location) GetChecking() Location_Checking {
-	if x != nil {
-		return x.Checking
-	}
-	return Location_UNKNOWN
-}
+type Shelf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Location) GetOverQuotaTime() int64 {
-	if x != nil {
-		return x.OverQuotaTime
-	}
-	return 0
+	// The row of this shelf within the unit, counted from 1 at the top
+	Row   int32           `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Slots []*PhysicalSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
 }
 
-func (x *Location) GetInPlay() Location_InPlay {
-	if x != nil {
-		return x.InPlay
+func (x *Shelf) Reset() {
+	*x = Shelf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Location_PLAY_UNKNOWN
 }
 
-func (x *Location) GetMediaType() Location_MediaType {
-	if x != nil {
-		return x.MediaType
-	}
-	return Location_UNKNOWN_TYPE
+func (x *Shelf) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Location) GetAllowAdjust() bool {
-	if x != nil {
-		return x.AllowAdjust
+func (*Shelf) ProtoMessage() {}
+
+func (x *Shelf) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Location) GetCombineSimilar() bool {
-	if x != nil {
-		return x.CombineSimilar
-	}
-	return false
+// Deprecated: Use Shelf.ProtoReflect.Descriptor instead.
+func (*Shelf) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Location) GetSlotsToSort() []int32 {
+func (x *Shelf) GetRow() int32 {
 	if x != nil {
-		return x.SlotsToSort
+		return x.Row
 	}
-	return nil
+	return 0
 }
 
-func (x *Location) GetLastSort() int32 {
+func (x *Shelf) GetSlots() []*PhysicalSlot {
 	if x != nil {
-		return x.LastSort
+		return x.Slots
 	}
-	return 0
+	return nil
 }
 
-type Organisation struct {
+type Unit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Timestamp this organisation was made
-	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The locations in this sorting
-	Locations []*Location `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
-	// The extractors used for sorting
-	Extractors []*LabelExtractor `protobuf:"bytes,3,rep,name=extractors,proto3" json:"extractors,omitempty"`
-	// A list of mappings for the releases
-	SortMappings []*SortMapping `protobuf:"bytes,4,rep,name=sort_mappings,json=sortMappings,proto3" json:"sort_mappings,omitempty"`
+	// The name of the unit (e.g. Kallax B)
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shelves []*Shelf `protobuf:"bytes,2,rep,name=shelves,proto3" json:"shelves,omitempty"`
 }
 
-func (x *Organisation) Reset() {
-	*x = Organisation{}
+func (x *Unit) Reset() {
+	*x = Unit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[8]
+		mi := &file_organise_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organisation) String() string {
+func (x *Unit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organisation) ProtoMessage() {}
+func (*Unit) ProtoMessage() {}
 
-func (x *Organisation) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[8]
+func (x *Unit) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,64 +1229,51 @@ func (x *Organisation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organisation.ProtoReflect.Descriptor instead.
-func (*Organisation) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Organisation) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *Organisation) GetLocations() []*Location {
-	if x != nil {
-		return x.Locations
-	}
-	return nil
+// Deprecated: Use Unit.ProtoReflect.Descriptor instead.
+func (*Unit) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Organisation) GetExtractors() []*LabelExtractor {
+func (x *Unit) GetName() string {
 	if x != nil {
-		return x.Extractors
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Organisation) GetSortMappings() []*SortMapping {
+func (x *Unit) GetShelves() []*Shelf {
 	if x != nil {
-		return x.SortMappings
+		return x.Shelves
 	}
 	return nil
 }
 
-type AddLocationRequest struct {
+type Room struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Add *Location `protobuf:"bytes,1,opt,name=add,proto3" json:"add,omitempty"`
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Units []*Unit `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
 }
 
-func (x *AddLocationRequest) Reset() {
-	*x = AddLocationRequest{}
+func (x *Room) Reset() {
+	*x = Room{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[9]
+		mi := &file_organise_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddLocationRequest) String() string {
+func (x *Room) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLocationRequest) ProtoMessage() {}
+func (*Room) ProtoMessage() {}
 
-func (x *AddLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[9]
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,43 +1284,53 @@ func (x *AddLocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLocationRequest.ProtoReflect.Descriptor instead.
-func (*AddLocationRequest) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AddLocationRequest) GetAdd() *Location {
+func (x *Room) GetName() string {
 	if x != nil {
-		return x.Add
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetUnits() []*Unit {
+	if x != nil {
+		return x.Units
 	}
 	return nil
 }
 
-type AddLocationResponse struct {
+type PhysicalPath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Now *Organisation `protobuf:"bytes,1,opt,name=now,proto3" json:"now,omitempty"`
+	Room   string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Unit   string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Row    int32  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
 }
 
-func (x *AddLocationResponse) Reset() {
-	*x = AddLocationResponse{}
+func (x *PhysicalPath) Reset() {
+	*x = PhysicalPath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[10]
+		mi := &file_organise_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddLocationResponse) String() string {
+func (x *PhysicalPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLocationResponse) ProtoMessage() {}
+func (*PhysicalPath) ProtoMessage() {}
 
-func (x *AddLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[10]
+func (x *PhysicalPath) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,47 +1341,69 @@ func (x *AddLocationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLocationResponse.ProtoReflect.Descriptor instead.
-func (*AddLocationResponse) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PhysicalPath.ProtoReflect.Descriptor instead.
+func (*PhysicalPath) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AddLocationResponse) GetNow() *Organisation {
+func (x *PhysicalPath) GetRoom() string {
 	if x != nil {
-		return x.Now
+		return x.Room
 	}
-	return nil
+	return ""
 }
 
-type GetOrganisationRequest struct {
+func (x *PhysicalPath) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PhysicalPath) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *PhysicalPath) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type SizeRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*Location `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	// Force a reorg on returned locations
-	ForceReorg bool `protobuf:"varint,2,opt,name=force_reorg,json=forceReorg,proto3" json:"force_reorg,omitempty"`
-	// Reset the reorg time
-	OrgReset bool `protobuf:"varint,3,opt,name=org_reset,json=orgReset,proto3" json:"org_reset,omitempty"`
+	// The size class being routed
+	SizeClass string `protobuf:"bytes,1,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	// The slot these records start in, zero follows on from the previous group
+	StartSlot int32 `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	// A name for this part of the location
+	SubLocation string `protobuf:"bytes,3,opt,name=sub_location,json=subLocation,proto3" json:"sub_location,omitempty"`
 }
 
-func (x *GetOrganisationRequest) Reset() {
-	*x = GetOrganisationRequest{}
+func (x *SizeRoute) Reset() {
+	*x = SizeRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[11]
+		mi := &file_organise_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganisationRequest) String() string {
+func (x *SizeRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganisationRequest) ProtoMessage() {}
+func (*SizeRoute) ProtoMessage() {}
 
-func (x *GetOrganisationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[11]
+func (x *SizeRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,58 +1414,97 @@ func (x *GetOrganisationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganisationRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganisationRequest) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SizeRoute.ProtoReflect.Descriptor instead.
+func (*SizeRoute) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetOrganisationRequest) GetLocations() []*Location {
+func (x *SizeRoute) GetSizeClass() string {
 	if x != nil {
-		return x.Locations
+		return x.SizeClass
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrganisationRequest) GetForceReorg() bool {
+func (x *SizeRoute) GetStartSlot() int32 {
 	if x != nil {
-		return x.ForceReorg
+		return x.StartSlot
 	}
-	return false
+	return 0
 }
 
-func (x *GetOrganisationRequest) GetOrgReset() bool {
+func (x *SizeRoute) GetSubLocation() string {
 	if x != nil {
-		return x.OrgReset
+		return x.SubLocation
 	}
-	return false
+	return ""
 }
 
-type GetOrganisationResponse struct {
+type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations       []*Location `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	NumberProcessed int32       `protobuf:"varint,2,opt,name=number_processed,json=numberProcessed,proto3" json:"number_processed,omitempty"`
+	// The name of the location
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of units within the location
+	Slots int32 `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	// The folder ids that are stored in this location
+	FolderIds   []int32                    `protobuf:"varint,3,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
+	FolderOrder map[int32]int32            `protobuf:"bytes,19,rep,name=folder_order,json=folderOrder,proto3" json:"folder_order,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	FolderSort  map[int32]Location_Sorting `protobuf:"bytes,20,rep,name=folder_sort,json=folderSort,proto3" json:"folder_sort,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=recordsorganiser.Location_Sorting"`
+	HardGap     map[int32]bool             `protobuf:"bytes,21,rep,name=hard_gap,json=hardGap,proto3" json:"hard_gap,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The placement of releases in the folder
+	ReleasesLocation []*ReleasePlacement `protobuf:"bytes,4,rep,name=releases_location,json=releasesLocation,proto3" json:"releases_location,omitempty"`
+	Sort             Location_Sorting    `protobuf:"varint,5,opt,name=sort,proto3,enum=recordsorganiser.Location_Sorting" json:"sort,omitempty"`
+	// The timestamp of this given location / arrangement
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The allowed quota for this location, if any
+	Quota *Quota `protobuf:"bytes,7,opt,name=quota,proto3" json:"quota,omitempty"`
+	// The type of format we expect in this location
+	ExpectedFormat string `protobuf:"bytes,8,opt,name=expected_format,json=expectedFormat,proto3" json:"expected_format,omitempty"`
+	// The type of label we don't expect in this location
+	UnexpectedLabel string `protobuf:"bytes,9,opt,name=unexpected_label,json=unexpectedLabel,proto3" json:"unexpected_label,omitempty"`
+	// Don't send an alert when there are quota problems
+	NoAlert bool `protobuf:"varint,10,opt,name=no_alert,json=noAlert,proto3" json:"no_alert,omitempty"`
+	// Folder to move to when this is over quota
+	SpillFolder int32 `protobuf:"varint,11,opt,name=spill_folder,json=spillFolder,proto3" json:"spill_folder,omitempty"`
+	// Quota Check opt out - if set no alerts on quota
+	OptOutQuotaChecks bool `protobuf:"varint,12,opt,name=opt_out_quota_checks,json=optOutQuotaChecks,proto3" json:"opt_out_quota_checks,omitempty"`
+	// Time to include reorgs, if < 0 we don't reorg
+	ReorgTime      int64              `protobuf:"varint,13,opt,name=reorg_time,json=reorgTime,proto3" json:"reorg_time,omitempty"`
+	LastReorg      int64              `protobuf:"varint,14,opt,name=last_reorg,json=lastReorg,proto3" json:"last_reorg,omitempty"`
+	Checking       Location_Checking  `protobuf:"varint,15,opt,name=checking,proto3,enum=recordsorganiser.Location_Checking" json:"checking,omitempty"`
+	OverQuotaTime  int64              `protobuf:"varint,16,opt,name=over_quota_time,json=overQuotaTime,proto3" json:"over_quota_time,omitempty"`
+	InPlay         Location_InPlay    `protobuf:"varint,17,opt,name=in_play,json=inPlay,proto3,enum=recordsorganiser.Location_InPlay" json:"in_play,omitempty"`
+	MediaType      Location_MediaType `protobuf:"varint,18,opt,name=media_type,json=mediaType,proto3,enum=recordsorganiser.Location_MediaType" json:"media_type,omitempty"`
+	AllowAdjust    bool               `protobuf:"varint,22,opt,name=allow_adjust,json=allowAdjust,proto3" json:"allow_adjust,omitempty"`
+	CombineSimilar bool               `protobuf:"varint,23,opt,name=combine_similar,json=combineSimilar,proto3" json:"combine_similar,omitempty"`
+	SlotsToSort    []int32            `protobuf:"varint,24,rep,packed,name=slots_to_sort,json=slotsToSort,proto3" json:"slots_to_sort,omitempty"`
+	LastSort       int32              `protobuf:"varint,25,opt,name=last_sort,json=lastSort,proto3" json:"last_sort,omitempty"`
+	// Explicit slot definitions, overrides slots and quota total width
+	SlotDefinitions []*SlotDefinition `protobuf:"bytes,26,rep,name=slot_definitions,json=slotDefinitions,proto3" json:"slot_definitions,omitempty"`
+	// Size classes which are shelved apart from the rest of the location
+	SizeRoutes []*SizeRoute `protobuf:"bytes,27,rep,name=size_routes,json=sizeRoutes,proto3" json:"size_routes,omitempty"`
 }
 
-func (x *GetOrganisationResponse) Reset() {
-	*x = GetOrganisationResponse{}
+func (x *Location) Reset() {
+	*x = Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[12]
+		mi := &file_organise_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganisationResponse) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganisationResponse) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *GetOrganisationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[12]
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,204 +1515,227 @@ func (x *GetOrganisationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganisationResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganisationResponse) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetOrganisationResponse) GetLocations() []*Location {
+func (x *Location) GetName() string {
 	if x != nil {
-		return x.Locations
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrganisationResponse) GetNumberProcessed() int32 {
+func (x *Location) GetSlots() int32 {
 	if x != nil {
-		return x.NumberProcessed
+		return x.Slots
 	}
 	return 0
 }
 
-type LocateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InstanceId int64 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	FolderId   int32 `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+func (x *Location) GetFolderIds() []int32 {
+	if x != nil {
+		return x.FolderIds
+	}
+	return nil
 }
 
-func (x *LocateRequest) Reset() {
-	*x = LocateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Location) GetFolderOrder() map[int32]int32 {
+	if x != nil {
+		return x.FolderOrder
 	}
+	return nil
 }
 
-func (x *LocateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Location) GetFolderSort() map[int32]Location_Sorting {
+	if x != nil {
+		return x.FolderSort
+	}
+	return nil
 }
 
-func (*LocateRequest) ProtoMessage() {}
-
-func (x *LocateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Location) GetHardGap() map[int32]bool {
+	if x != nil {
+		return x.HardGap
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use LocateRequest.ProtoReflect.Descriptor instead.
-func (*LocateRequest) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{13}
+func (x *Location) GetReleasesLocation() []*ReleasePlacement {
+	if x != nil {
+		return x.ReleasesLocation
+	}
+	return nil
 }
 
-func (x *LocateRequest) GetInstanceId() int64 {
+func (x *Location) GetSort() Location_Sorting {
 	if x != nil {
-		return x.InstanceId
+		return x.Sort
 	}
-	return 0
+	return Location_BY_LABEL_CATNO
 }
 
-func (x *LocateRequest) GetFolderId() int32 {
+func (x *Location) GetTimestamp() int64 {
 	if x != nil {
-		return x.FolderId
+		return x.Timestamp
 	}
 	return 0
 }
 
-type LocateResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FoundLocation *Location `protobuf:"bytes,2,opt,name=found_location,json=foundLocation,proto3" json:"found_location,omitempty"`
-}
-
-func (x *LocateResponse) Reset() {
-	*x = LocateResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Location) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
 	}
+	return nil
 }
 
-func (x *LocateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LocateResponse) ProtoMessage() {}
-
-func (x *LocateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Location) GetExpectedFormat() string {
+	if x != nil {
+		return x.ExpectedFormat
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LocateResponse.ProtoReflect.Descriptor instead.
-func (*LocateResponse) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{14}
+func (x *Location) GetUnexpectedLabel() string {
+	if x != nil {
+		return x.UnexpectedLabel
+	}
+	return ""
 }
 
-func (x *LocateResponse) GetFoundLocation() *Location {
+func (x *Location) GetNoAlert() bool {
 	if x != nil {
-		return x.FoundLocation
+		return x.NoAlert
 	}
-	return nil
+	return false
 }
 
-type QuotaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FolderId       int32  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
-	IncludeRecords bool   `protobuf:"varint,2,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty"`
-	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Location) GetSpillFolder() int32 {
+	if x != nil {
+		return x.SpillFolder
+	}
+	return 0
 }
 
-func (x *QuotaRequest) Reset() {
-	*x = QuotaRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Location) GetOptOutQuotaChecks() bool {
+	if x != nil {
+		return x.OptOutQuotaChecks
 	}
+	return false
 }
 
-func (x *QuotaRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Location) GetReorgTime() int64 {
+	if x != nil {
+		return x.ReorgTime
+	}
+	return 0
 }
 
-func (*QuotaRequest) ProtoMessage() {}
-
-func (x *QuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Location) GetLastReorg() int64 {
+	if x != nil {
+		return x.LastReorg
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use QuotaRequest.ProtoReflect.Descriptor instead.
-func (*QuotaRequest) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{15}
+func (x *Location) GetChecking() Location_Checking {
+	if x != nil {
+		return x.Checking
+	}
+	return Location_UNKNOWN
 }
 
-func (x *QuotaRequest) GetFolderId() int32 {
+func (x *Location) GetOverQuotaTime() int64 {
 	if x != nil {
-		return x.FolderId
+		return x.OverQuotaTime
 	}
 	return 0
 }
 
-func (x *QuotaRequest) GetIncludeRecords() bool {
+func (x *Location) GetInPlay() Location_InPlay {
 	if x != nil {
-		return x.IncludeRecords
+		return x.InPlay
 	}
-	return false
+	return Location_PLAY_UNKNOWN
 }
 
-func (x *QuotaRequest) GetName() string {
+func (x *Location) GetMediaType() Location_MediaType {
 	if x != nil {
-		return x.Name
+		return x.MediaType
 	}
-	return ""
+	return Location_UNKNOWN_TYPE
 }
 
-type QuotaResponse struct {
+func (x *Location) GetAllowAdjust() bool {
+	if x != nil {
+		return x.AllowAdjust
+	}
+	return false
+}
+
+func (x *Location) GetCombineSimilar() bool {
+	if x != nil {
+		return x.CombineSimilar
+	}
+	return false
+}
+
+func (x *Location) GetSlotsToSort() []int32 {
+	if x != nil {
+		return x.SlotsToSort
+	}
+	return nil
+}
+
+func (x *Location) GetLastSort() int32 {
+	if x != nil {
+		return x.LastSort
+	}
+	return 0
+}
+
+func (x *Location) GetSlotDefinitions() []*SlotDefinition {
+	if x != nil {
+		return x.SlotDefinitions
+	}
+	return nil
+}
+
+func (x *Location) GetSizeRoutes() []*SizeRoute {
+	if x != nil {
+		return x.SizeRoutes
+	}
+	return nil
+}
+
+type Organisation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OverQuota    bool    `protobuf:"varint,1,opt,name=over_quota,json=overQuota,proto3" json:"over_quota,omitempty"`
-	SpillFolder  int32   `protobuf:"varint,2,opt,name=spill_folder,json=spillFolder,proto3" json:"spill_folder,omitempty"`
-	LocationName string  `protobuf:"bytes,3,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
-	InstanceId   []int64 `protobuf:"varint,4,rep,packed,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Quota        *Quota  `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Timestamp this organisation was made
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The locations in this sorting
+	Locations []*Location `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+	// The extractors used for sorting
+	Extractors []*LabelExtractor `protobuf:"bytes,3,rep,name=extractors,proto3" json:"extractors,omitempty"`
+	// A list of mappings for the releases
+	SortMappings []*SortMapping `protobuf:"bytes,4,rep,name=sort_mappings,json=sortMappings,proto3" json:"sort_mappings,omitempty"`
+	// Rules protecting records from automatic sale
+	Protection *ProtectionRules `protobuf:"bytes,5,opt,name=protection,proto3" json:"protection,omitempty"`
+	// Multiplier from spine width to shelf width, keyed by sleeve state
+	SleeveFactors map[int32]float32 `protobuf:"bytes,6,rep,name=sleeve_factors,json=sleeveFactors,proto3" json:"sleeve_factors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// The physical rooms which hold the locations
+	Rooms []*Room `protobuf:"bytes,7,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// Canonical names and families for labels, keyed by label id
+	LabelAliases []*LabelAlias `protobuf:"bytes,8,rep,name=label_aliases,json=labelAliases,proto3" json:"label_aliases,omitempty"`
+	// Per record choices of filing label, overriding the main label
+	LabelOverrides []*LabelOverride `protobuf:"bytes,9,rep,name=label_overrides,json=labelOverrides,proto3" json:"label_overrides,omitempty"`
 }
 
-func (x *QuotaResponse) Reset() {
-	*x = QuotaResponse{}
+func (x *Organisation) Reset() {
+	*x = Organisation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_organise_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1454,13 +1743,13 @@ func (x *QuotaResponse) Reset() {
 	}
 }
 
-func (x *QuotaResponse) String() string {
+func (x *Organisation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuotaResponse) ProtoMessage() {}
+func (*Organisation) ProtoMessage() {}
 
-func (x *QuotaResponse) ProtoReflect() protoreflect.Message {
+func (x *Organisation) ProtoReflect() protoreflect.Message {
 	mi := &file_organise_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,58 +1761,88 @@ func (x *QuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuotaResponse.ProtoReflect.Descriptor instead.
-func (*QuotaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Organisation.ProtoReflect.Descriptor instead.
+func (*Organisation) Descriptor() ([]byte, []int) {
 	return file_organise_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QuotaResponse) GetOverQuota() bool {
+func (x *Organisation) GetTimestamp() int64 {
 	if x != nil {
-		return x.OverQuota
+		return x.Timestamp
 	}
-	return false
+	return 0
 }
 
-func (x *QuotaResponse) GetSpillFolder() int32 {
+func (x *Organisation) GetLocations() []*Location {
 	if x != nil {
-		return x.SpillFolder
+		return x.Locations
 	}
-	return 0
+	return nil
 }
 
-func (x *QuotaResponse) GetLocationName() string {
+func (x *Organisation) GetExtractors() []*LabelExtractor {
 	if x != nil {
-		return x.LocationName
+		return x.Extractors
 	}
-	return ""
+	return nil
 }
 
-func (x *QuotaResponse) GetInstanceId() []int64 {
+func (x *Organisation) GetSortMappings() []*SortMapping {
 	if x != nil {
-		return x.InstanceId
+		return x.SortMappings
 	}
 	return nil
 }
 
-func (x *QuotaResponse) GetQuota() *Quota {
+func (x *Organisation) GetProtection() *ProtectionRules {
 	if x != nil {
-		return x.Quota
+		return x.Protection
 	}
 	return nil
 }
 
-type UpdateLocationRequest struct {
+func (x *Organisation) GetSleeveFactors() map[int32]float32 {
+	if x != nil {
+		return x.SleeveFactors
+	}
+	return nil
+}
+
+func (x *Organisation) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *Organisation) GetLabelAliases() []*LabelAlias {
+	if x != nil {
+		return x.LabelAliases
+	}
+	return nil
+}
+
+func (x *Organisation) GetLabelOverrides() []*LabelOverride {
+	if x != nil {
+		return x.LabelOverrides
+	}
+	return nil
+}
+
+type LabelOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location       string    `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Update         *Location `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
-	DeleteLocation bool      `protobuf:"varint,3,opt,name=delete_location,json=deleteLocation,proto3" json:"delete_location,omitempty"`
+	InstanceId int64 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// The label to file under, one of the labels on the release
+	LabelId int32 `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	// Replaces the label's catalogue number if set
+	Catno string `protobuf:"bytes,3,opt,name=catno,proto3" json:"catno,omitempty"`
 }
 
-func (x *UpdateLocationRequest) Reset() {
-	*x = UpdateLocationRequest{}
+func (x *LabelOverride) Reset() {
+	*x = LabelOverride{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_organise_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1531,13 +1850,13 @@ func (x *UpdateLocationRequest) Reset() {
 	}
 }
 
-func (x *UpdateLocationRequest) String() string {
+func (x *LabelOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLocationRequest) ProtoMessage() {}
+func (*LabelOverride) ProtoMessage() {}
 
-func (x *UpdateLocationRequest) ProtoReflect() protoreflect.Message {
+func (x *LabelOverride) ProtoReflect() protoreflect.Message {
 	mi := &file_organise_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1549,40 +1868,46 @@ func (x *UpdateLocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLocationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LabelOverride.ProtoReflect.Descriptor instead.
+func (*LabelOverride) Descriptor() ([]byte, []int) {
 	return file_organise_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateLocationRequest) GetLocation() string {
+func (x *LabelOverride) GetInstanceId() int64 {
 	if x != nil {
-		return x.Location
+		return x.InstanceId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLocationRequest) GetUpdate() *Location {
+func (x *LabelOverride) GetLabelId() int32 {
 	if x != nil {
-		return x.Update
+		return x.LabelId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateLocationRequest) GetDeleteLocation() bool {
+func (x *LabelOverride) GetCatno() string {
 	if x != nil {
-		return x.DeleteLocation
+		return x.Catno
 	}
-	return false
+	return ""
 }
 
-type UpdateLocationResponse struct {
+type LabelAlias struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LabelId int32 `protobuf:"varint,1,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	// The name to use for the label, e.g. Warp for Warp Records
+	CanonicalName string `protobuf:"bytes,2,opt,name=canonical_name,json=canonicalName,proto3" json:"canonical_name,omitempty"`
+	// The parent label, sub-labels of the same family are shelved together
+	Family string `protobuf:"bytes,3,opt,name=family,proto3" json:"family,omitempty"`
 }
 
-func (x *UpdateLocationResponse) Reset() {
-	*x = UpdateLocationResponse{}
+func (x *LabelAlias) Reset() {
+	*x = LabelAlias{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_organise_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1590,13 +1915,13 @@ func (x *UpdateLocationResponse) Reset() {
 	}
 }
 
-func (x *UpdateLocationResponse) String() string {
+func (x *LabelAlias) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLocationResponse) ProtoMessage() {}
+func (*LabelAlias) ProtoMessage() {}
 
-func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
+func (x *LabelAlias) ProtoReflect() protoreflect.Message {
 	mi := &file_organise_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1608,21 +1933,45 @@ func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLocationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLocationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LabelAlias.ProtoReflect.Descriptor instead.
+func (*LabelAlias) Descriptor() ([]byte, []int) {
 	return file_organise_proto_rawDescGZIP(), []int{18}
 }
 
-type AddExtractorRequest struct {
+func (x *LabelAlias) GetLabelId() int32 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *LabelAlias) GetCanonicalName() string {
+	if x != nil {
+		return x.CanonicalName
+	}
+	return ""
+}
+
+func (x *LabelAlias) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+type ProtectionRules struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Extractor *LabelExtractor `protobuf:"bytes,1,opt,name=extractor,proto3" json:"extractor,omitempty"`
+	// Instance ids which should never be picked for sale
+	PinnedIds []int64 `protobuf:"varint,1,rep,packed,name=pinned_ids,json=pinnedIds,proto3" json:"pinned_ids,omitempty"`
+	// Records owned for less than this are not picked for sale
+	MinOwnershipSeconds int64 `protobuf:"varint,2,opt,name=min_ownership_seconds,json=minOwnershipSeconds,proto3" json:"min_ownership_seconds,omitempty"`
 }
 
-func (x *AddExtractorRequest) Reset() {
-	*x = AddExtractorRequest{}
+func (x *ProtectionRules) Reset() {
+	*x = ProtectionRules{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_organise_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1979,13 @@ func (x *AddExtractorRequest) Reset() {
 	}
 }
 
-func (x *AddExtractorRequest) String() string {
+func (x *ProtectionRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExtractorRequest) ProtoMessage() {}
+func (*ProtectionRules) ProtoMessage() {}
 
-func (x *AddExtractorRequest) ProtoReflect() protoreflect.Message {
+func (x *ProtectionRules) ProtoReflect() protoreflect.Message {
 	mi := &file_organise_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,26 +1997,35 @@ func (x *AddExtractorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExtractorRequest.ProtoReflect.Descriptor instead.
-func (*AddExtractorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtectionRules.ProtoReflect.Descriptor instead.
+func (*ProtectionRules) Descriptor() ([]byte, []int) {
 	return file_organise_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddExtractorRequest) GetExtractor() *LabelExtractor {
+func (x *ProtectionRules) GetPinnedIds() []int64 {
 	if x != nil {
-		return x.Extractor
+		return x.PinnedIds
 	}
 	return nil
 }
 
-type AddExtractorResponse struct {
+func (x *ProtectionRules) GetMinOwnershipSeconds() int64 {
+	if x != nil {
+		return x.MinOwnershipSeconds
+	}
+	return 0
+}
+
+type AddLocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Add *Location `protobuf:"bytes,1,opt,name=add,proto3" json:"add,omitempty"`
 }
 
-func (x *AddExtractorResponse) Reset() {
-	*x = AddExtractorResponse{}
+func (x *AddLocationRequest) Reset() {
+	*x = AddLocationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_organise_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1675,525 +2033,5740 @@ func (x *AddExtractorResponse) Reset() {
 	}
 }
 
-func (x *AddExtractorResponse) String() string {
+func (x *AddLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExtractorResponse) ProtoMessage() {}
+func (*AddLocationRequest) ProtoMessage() {}
 
-func (x *AddExtractorResponse) ProtoReflect() protoreflect.Message {
+func (x *AddLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_organise_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AddExtractorResponse.ProtoReflect.Descriptor instead.
-func (*AddExtractorResponse) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{20}
-}
-
-type GetCacheRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetCacheRequest) Reset() {
-	*x = GetCacheRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetCacheRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCacheRequest) ProtoMessage() {}
-
-func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocationRequest.ProtoReflect.Descriptor instead.
+func (*AddLocationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddLocationRequest) GetAdd() *Location {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+type AddLocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Now *Organisation `protobuf:"bytes,1,opt,name=now,proto3" json:"now,omitempty"`
+}
+
+func (x *AddLocationResponse) Reset() {
+	*x = AddLocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocationResponse) ProtoMessage() {}
+
+func (x *AddLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocationResponse.ProtoReflect.Descriptor instead.
+func (*AddLocationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddLocationResponse) GetNow() *Organisation {
+	if x != nil {
+		return x.Now
+	}
+	return nil
+}
+
+type GetOrganisationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations []*Location `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	// Force a reorg on returned locations
+	ForceReorg bool `protobuf:"varint,2,opt,name=force_reorg,json=forceReorg,proto3" json:"force_reorg,omitempty"`
+	// Reset the reorg time
+	OrgReset bool `protobuf:"varint,3,opt,name=org_reset,json=orgReset,proto3" json:"org_reset,omitempty"`
+	// Filters on the returned placement rows; setting any of these, a page size or
+	// placements_only returns rows, and the locations come without their placements
+	// on the first page only
+	SizeClass string `protobuf:"bytes,4,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	MinSlot   int32  `protobuf:"varint,5,opt,name=min_slot,json=minSlot,proto3" json:"min_slot,omitempty"`
+	MaxSlot   int32  `protobuf:"varint,6,opt,name=max_slot,json=maxSlot,proto3" json:"max_slot,omitempty"`
+	Folder    int32  `protobuf:"varint,7,opt,name=folder,proto3" json:"folder,omitempty"`
+	Category  string `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Title     string `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	// The number of rows to return, zero returns them all
+	PageSize  int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Return the rows without filtering them
+	PlacementsOnly bool `protobuf:"varint,12,opt,name=placements_only,json=placementsOnly,proto3" json:"placements_only,omitempty"`
+}
+
+func (x *GetOrganisationRequest) Reset() {
+	*x = GetOrganisationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganisationRequest) ProtoMessage() {}
+
+func (x *GetOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrganisationRequest) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *GetOrganisationRequest) GetForceReorg() bool {
+	if x != nil {
+		return x.ForceReorg
+	}
+	return false
+}
+
+func (x *GetOrganisationRequest) GetOrgReset() bool {
+	if x != nil {
+		return x.OrgReset
+	}
+	return false
+}
+
+func (x *GetOrganisationRequest) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+func (x *GetOrganisationRequest) GetMinSlot() int32 {
+	if x != nil {
+		return x.MinSlot
+	}
+	return 0
+}
+
+func (x *GetOrganisationRequest) GetMaxSlot() int32 {
+	if x != nil {
+		return x.MaxSlot
+	}
+	return 0
+}
+
+func (x *GetOrganisationRequest) GetFolder() int32 {
+	if x != nil {
+		return x.Folder
+	}
+	return 0
+}
+
+func (x *GetOrganisationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetOrganisationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetOrganisationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetOrganisationRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetOrganisationRequest) GetPlacementsOnly() bool {
+	if x != nil {
+		return x.PlacementsOnly
+	}
+	return false
+}
+
+type PlacementView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location  string            `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Placement *ReleasePlacement `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	// Details pulled from the sorting cache
+	Folder    int32   `protobuf:"varint,3,opt,name=folder,proto3" json:"folder,omitempty"`
+	Category  string  `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Label     string  `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	SizeClass string  `protobuf:"bytes,6,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	Width     float64 `protobuf:"fixed64,7,opt,name=width,proto3" json:"width,omitempty"`
+	Filed     string  `protobuf:"bytes,8,opt,name=filed,proto3" json:"filed,omitempty"`
+	Artist    string  `protobuf:"bytes,9,opt,name=artist,proto3" json:"artist,omitempty"`
+	Catno     string  `protobuf:"bytes,10,opt,name=catno,proto3" json:"catno,omitempty"`
+	Format    string  `protobuf:"bytes,11,opt,name=format,proto3" json:"format,omitempty"`
+	Sleeve    string  `protobuf:"bytes,12,opt,name=sleeve,proto3" json:"sleeve,omitempty"`
+	ReleaseId int32   `protobuf:"varint,13,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+}
+
+func (x *PlacementView) Reset() {
+	*x = PlacementView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementView) ProtoMessage() {}
+
+func (x *PlacementView) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementView.ProtoReflect.Descriptor instead.
+func (*PlacementView) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlacementView) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PlacementView) GetPlacement() *ReleasePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PlacementView) GetFolder() int32 {
+	if x != nil {
+		return x.Folder
+	}
+	return 0
+}
+
+func (x *PlacementView) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PlacementView) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PlacementView) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+func (x *PlacementView) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PlacementView) GetFiled() string {
+	if x != nil {
+		return x.Filed
+	}
+	return ""
+}
+
+func (x *PlacementView) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *PlacementView) GetCatno() string {
+	if x != nil {
+		return x.Catno
+	}
+	return ""
+}
+
+func (x *PlacementView) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PlacementView) GetSleeve() string {
+	if x != nil {
+		return x.Sleeve
+	}
+	return ""
+}
+
+func (x *PlacementView) GetReleaseId() int32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+type GetOrganisationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations       []*Location `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	NumberProcessed int32       `protobuf:"varint,2,opt,name=number_processed,json=numberProcessed,proto3" json:"number_processed,omitempty"`
+	// Only filled when the request asks for rows
+	Placements    []*PlacementView `protobuf:"bytes,3,rep,name=placements,proto3" json:"placements,omitempty"`
+	NextPageToken string           `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetOrganisationResponse) Reset() {
+	*x = GetOrganisationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganisationResponse) ProtoMessage() {}
+
+func (x *GetOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetOrganisationResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *GetOrganisationResponse) GetNumberProcessed() int32 {
+	if x != nil {
+		return x.NumberProcessed
+	}
+	return 0
+}
+
+func (x *GetOrganisationResponse) GetPlacements() []*PlacementView {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *GetOrganisationResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LocateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int64 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	FolderId   int32 `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// The number of neighbours to return on each side, one if unset
+	Neighbours *int32 `protobuf:"varint,3,opt,name=neighbours,proto3,oneof" json:"neighbours,omitempty"`
+}
+
+func (x *LocateRequest) Reset() {
+	*x = LocateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateRequest) ProtoMessage() {}
+
+func (x *LocateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateRequest.ProtoReflect.Descriptor instead.
+func (*LocateRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LocateRequest) GetInstanceId() int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *LocateRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *LocateRequest) GetNeighbours() int32 {
+	if x != nil && x.Neighbours != nil {
+		return *x.Neighbours
+	}
+	return 0
+}
+
+type LocateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FoundLocation *Location `protobuf:"bytes,2,opt,name=found_location,json=foundLocation,proto3" json:"found_location,omitempty"`
+	// The physical slot holding the record, if known
+	Path *PhysicalPath `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The position of the record within its slot, counted from 1
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// The record itself and its neighbours within the same slot, nearest last for before
+	Placement *PlacementView   `protobuf:"bytes,6,opt,name=placement,proto3" json:"placement,omitempty"`
+	Before    []*PlacementView `protobuf:"bytes,7,rep,name=before,proto3" json:"before,omitempty"`
+	After     []*PlacementView `protobuf:"bytes,8,rep,name=after,proto3" json:"after,omitempty"`
+	// The width between the record and each end of its slot
+	DistanceFromStart float32 `protobuf:"fixed32,9,opt,name=distance_from_start,json=distanceFromStart,proto3" json:"distance_from_start,omitempty"`
+	DistanceFromEnd   float32 `protobuf:"fixed32,10,opt,name=distance_from_end,json=distanceFromEnd,proto3" json:"distance_from_end,omitempty"`
+}
+
+func (x *LocateResponse) Reset() {
+	*x = LocateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateResponse) ProtoMessage() {}
+
+func (x *LocateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateResponse.ProtoReflect.Descriptor instead.
+func (*LocateResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LocateResponse) GetFoundLocation() *Location {
+	if x != nil {
+		return x.FoundLocation
+	}
+	return nil
+}
+
+func (x *LocateResponse) GetPath() *PhysicalPath {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *LocateResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LocateResponse) GetPlacement() *PlacementView {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *LocateResponse) GetBefore() []*PlacementView {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *LocateResponse) GetAfter() []*PlacementView {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *LocateResponse) GetDistanceFromStart() float32 {
+	if x != nil {
+		return x.DistanceFromStart
+	}
+	return 0
+}
+
+func (x *LocateResponse) GetDistanceFromEnd() float32 {
+	if x != nil {
+		return x.DistanceFromEnd
+	}
+	return 0
+}
+
+type QuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId       int32  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	IncludeRecords bool   `protobuf:"varint,2,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *QuotaRequest) Reset() {
+	*x = QuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaRequest) ProtoMessage() {}
+
+func (x *QuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaRequest.ProtoReflect.Descriptor instead.
+func (*QuotaRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QuotaRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *QuotaRequest) GetIncludeRecords() bool {
+	if x != nil {
+		return x.IncludeRecords
+	}
+	return false
+}
+
+func (x *QuotaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type QuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OverQuota    bool    `protobuf:"varint,1,opt,name=over_quota,json=overQuota,proto3" json:"over_quota,omitempty"`
+	SpillFolder  int32   `protobuf:"varint,2,opt,name=spill_folder,json=spillFolder,proto3" json:"spill_folder,omitempty"`
+	LocationName string  `protobuf:"bytes,3,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	InstanceId   []int64 `protobuf:"varint,4,rep,packed,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Quota        *Quota  `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Set if the width total includes estimated widths
+	Estimated bool `protobuf:"varint,6,opt,name=estimated,proto3" json:"estimated,omitempty"`
+	// The lowest confidence of any estimated width
+	Confidence float32 `protobuf:"fixed32,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *QuotaResponse) Reset() {
+	*x = QuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaResponse) ProtoMessage() {}
+
+func (x *QuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaResponse.ProtoReflect.Descriptor instead.
+func (*QuotaResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QuotaResponse) GetOverQuota() bool {
+	if x != nil {
+		return x.OverQuota
+	}
+	return false
+}
+
+func (x *QuotaResponse) GetSpillFolder() int32 {
+	if x != nil {
+		return x.SpillFolder
+	}
+	return 0
+}
+
+func (x *QuotaResponse) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *QuotaResponse) GetInstanceId() []int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return nil
+}
+
+func (x *QuotaResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *QuotaResponse) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
+func (x *QuotaResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type UpdateLocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location       string    `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Update         *Location `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+	DeleteLocation bool      `protobuf:"varint,3,opt,name=delete_location,json=deleteLocation,proto3" json:"delete_location,omitempty"`
+	// When set only the masked fields change; those missing from update are cleared
+	UpdateMask        *fieldmaskpb.FieldMask     `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	AddFolders        []int32                    `protobuf:"varint,5,rep,packed,name=add_folders,json=addFolders,proto3" json:"add_folders,omitempty"`
+	RemoveFolders     []int32                    `protobuf:"varint,6,rep,packed,name=remove_folders,json=removeFolders,proto3" json:"remove_folders,omitempty"`
+	AddHardGaps       []int32                    `protobuf:"varint,7,rep,packed,name=add_hard_gaps,json=addHardGaps,proto3" json:"add_hard_gaps,omitempty"`
+	RemoveHardGaps    []int32                    `protobuf:"varint,8,rep,packed,name=remove_hard_gaps,json=removeHardGaps,proto3" json:"remove_hard_gaps,omitempty"`
+	AddFolderSorts    map[int32]Location_Sorting `protobuf:"bytes,9,rep,name=add_folder_sorts,json=addFolderSorts,proto3" json:"add_folder_sorts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=recordsorganiser.Location_Sorting"`
+	RemoveFolderSorts []int32                    `protobuf:"varint,10,rep,packed,name=remove_folder_sorts,json=removeFolderSorts,proto3" json:"remove_folder_sorts,omitempty"`
+}
+
+func (x *UpdateLocationRequest) Reset() {
+	*x = UpdateLocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationRequest) ProtoMessage() {}
+
+func (x *UpdateLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateLocationRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateLocationRequest) GetUpdate() *Location {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetDeleteLocation() bool {
+	if x != nil {
+		return x.DeleteLocation
+	}
+	return false
+}
+
+func (x *UpdateLocationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetAddFolders() []int32 {
+	if x != nil {
+		return x.AddFolders
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetRemoveFolders() []int32 {
+	if x != nil {
+		return x.RemoveFolders
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetAddHardGaps() []int32 {
+	if x != nil {
+		return x.AddHardGaps
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetRemoveHardGaps() []int32 {
+	if x != nil {
+		return x.RemoveHardGaps
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetAddFolderSorts() map[int32]Location_Sorting {
+	if x != nil {
+		return x.AddFolderSorts
+	}
+	return nil
+}
+
+func (x *UpdateLocationRequest) GetRemoveFolderSorts() []int32 {
+	if x != nil {
+		return x.RemoveFolderSorts
+	}
+	return nil
+}
+
+type UpdateLocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateLocationResponse) Reset() {
+	*x = UpdateLocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationResponse) ProtoMessage() {}
+
+func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{30}
+}
+
+type AddExtractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extractor *LabelExtractor `protobuf:"bytes,1,opt,name=extractor,proto3" json:"extractor,omitempty"`
+}
+
+func (x *AddExtractorRequest) Reset() {
+	*x = AddExtractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddExtractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExtractorRequest) ProtoMessage() {}
+
+func (x *AddExtractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExtractorRequest.ProtoReflect.Descriptor instead.
+func (*AddExtractorRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddExtractorRequest) GetExtractor() *LabelExtractor {
+	if x != nil {
+		return x.Extractor
+	}
+	return nil
+}
+
+type AddExtractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddExtractorResponse) Reset() {
+	*x = AddExtractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddExtractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExtractorResponse) ProtoMessage() {}
+
+func (x *AddExtractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExtractorResponse.ProtoReflect.Descriptor instead.
+func (*AddExtractorResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{32}
+}
+
+type ListExtractorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListExtractorsRequest) Reset() {
+	*x = ListExtractorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExtractorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExtractorsRequest) ProtoMessage() {}
+
+func (x *ListExtractorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExtractorsRequest.ProtoReflect.Descriptor instead.
+func (*ListExtractorsRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{33}
+}
+
+type ListExtractorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extractors []*LabelExtractor `protobuf:"bytes,1,rep,name=extractors,proto3" json:"extractors,omitempty"`
+}
+
+func (x *ListExtractorsResponse) Reset() {
+	*x = ListExtractorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExtractorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExtractorsResponse) ProtoMessage() {}
+
+func (x *ListExtractorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExtractorsResponse.ProtoReflect.Descriptor instead.
+func (*ListExtractorsResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListExtractorsResponse) GetExtractors() []*LabelExtractor {
+	if x != nil {
+		return x.Extractors
+	}
+	return nil
+}
+
+type RemoveExtractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabelId int32 `protobuf:"varint,1,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+}
+
+func (x *RemoveExtractorRequest) Reset() {
+	*x = RemoveExtractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveExtractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExtractorRequest) ProtoMessage() {}
+
+func (x *RemoveExtractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExtractorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveExtractorRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RemoveExtractorRequest) GetLabelId() int32 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+type RemoveExtractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveExtractorResponse) Reset() {
+	*x = RemoveExtractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveExtractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExtractorResponse) ProtoMessage() {}
+
+func (x *RemoveExtractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExtractorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveExtractorResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{36}
+}
+
+type TestExtractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extractor *LabelExtractor `protobuf:"bytes,1,opt,name=extractor,proto3" json:"extractor,omitempty"`
+}
+
+func (x *TestExtractorRequest) Reset() {
+	*x = TestExtractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestExtractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestExtractorRequest) ProtoMessage() {}
+
+func (x *TestExtractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestExtractorRequest.ProtoReflect.Descriptor instead.
+func (*TestExtractorRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TestExtractorRequest) GetExtractor() *LabelExtractor {
+	if x != nil {
+		return x.Extractor
+	}
+	return nil
+}
+
+type ExtractedCatno struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId int64  `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Catno      string `protobuf:"bytes,2,opt,name=catno,proto3" json:"catno,omitempty"`
+	// The parts used for sorting; if the extractor matches nothing the catno is split instead
+	Parts   []string `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	Matched bool     `protobuf:"varint,4,opt,name=matched,proto3" json:"matched,omitempty"`
+}
+
+func (x *ExtractedCatno) Reset() {
+	*x = ExtractedCatno{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractedCatno) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractedCatno) ProtoMessage() {}
+
+func (x *ExtractedCatno) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractedCatno.ProtoReflect.Descriptor instead.
+func (*ExtractedCatno) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExtractedCatno) GetInstanceId() int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *ExtractedCatno) GetCatno() string {
+	if x != nil {
+		return x.Catno
+	}
+	return ""
+}
+
+func (x *ExtractedCatno) GetParts() []string {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ExtractedCatno) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+type TestExtractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cached records on the label, in the order the extractor would sort them
+	Sorted    []*ExtractedCatno `protobuf:"bytes,1,rep,name=sorted,proto3" json:"sorted,omitempty"`
+	Unmatched int32             `protobuf:"varint,2,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+}
+
+func (x *TestExtractorResponse) Reset() {
+	*x = TestExtractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestExtractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestExtractorResponse) ProtoMessage() {}
+
+func (x *TestExtractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestExtractorResponse.ProtoReflect.Descriptor instead.
+func (*TestExtractorResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TestExtractorResponse) GetSorted() []*ExtractedCatno {
+	if x != nil {
+		return x.Sorted
+	}
+	return nil
+}
+
+func (x *TestExtractorResponse) GetUnmatched() int32 {
+	if x != nil {
+		return x.Unmatched
+	}
+	return 0
+}
+
+type UpdateProtectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pin   []int64 `protobuf:"varint,1,rep,packed,name=pin,proto3" json:"pin,omitempty"`
+	Unpin []int64 `protobuf:"varint,2,rep,packed,name=unpin,proto3" json:"unpin,omitempty"`
+	// Replaces the minimum ownership age if set, -1 clears it
+	MinOwnershipSeconds int64 `protobuf:"varint,3,opt,name=min_ownership_seconds,json=minOwnershipSeconds,proto3" json:"min_ownership_seconds,omitempty"`
+}
+
+func (x *UpdateProtectionRequest) Reset() {
+	*x = UpdateProtectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProtectionRequest) ProtoMessage() {}
+
+func (x *UpdateProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProtectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateProtectionRequest) GetPin() []int64 {
+	if x != nil {
+		return x.Pin
+	}
+	return nil
+}
+
+func (x *UpdateProtectionRequest) GetUnpin() []int64 {
+	if x != nil {
+		return x.Unpin
+	}
+	return nil
+}
+
+func (x *UpdateProtectionRequest) GetMinOwnershipSeconds() int64 {
+	if x != nil {
+		return x.MinOwnershipSeconds
+	}
+	return 0
+}
+
+type UpdateProtectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules *ProtectionRules `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *UpdateProtectionResponse) Reset() {
+	*x = UpdateProtectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProtectionResponse) ProtoMessage() {}
+
+func (x *UpdateProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProtectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateProtectionResponse) GetRules() *ProtectionRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The record that was updated
+	InstanceId int64 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// What we did to the record
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// The reason sent with the update
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The location that triggered the update
+	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// The state of the location quota at the time
+	QuotaState string `protobuf:"bytes,5,opt,name=quota_state,json=quotaState,proto3" json:"quota_state,omitempty"`
+	Timestamp  int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuditEntry) GetInstanceId() int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetQuotaState() string {
+	if x != nil {
+		return x.QuotaState
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AuditLog) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type QueryAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location   string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	InstanceId int64  `protobuf:"varint,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	StartTime  int64  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    int64  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *QueryAuditRequest) Reset() {
+	*x = QueryAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditRequest) ProtoMessage() {}
+
+func (x *QueryAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QueryAuditRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *QueryAuditRequest) GetInstanceId() int64 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+func (x *QueryAuditRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryAuditRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type QueryAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *QueryAuditResponse) Reset() {
+	*x = QueryAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditResponse) ProtoMessage() {}
+
+func (x *QueryAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *QueryAuditResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetSleeveFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sleeve state this applies to
+	Sleeve int32 `protobuf:"varint,1,opt,name=sleeve,proto3" json:"sleeve,omitempty"`
+	// The multiplier to apply, zero resets to the default
+	Factor float32 `protobuf:"fixed32,2,opt,name=factor,proto3" json:"factor,omitempty"`
+}
+
+func (x *SetSleeveFactorRequest) Reset() {
+	*x = SetSleeveFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSleeveFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSleeveFactorRequest) ProtoMessage() {}
+
+func (x *SetSleeveFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSleeveFactorRequest.ProtoReflect.Descriptor instead.
+func (*SetSleeveFactorRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetSleeveFactorRequest) GetSleeve() int32 {
+	if x != nil {
+		return x.Sleeve
+	}
+	return 0
+}
+
+func (x *SetSleeveFactorRequest) GetFactor() float32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type SetSleeveFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SleeveFactors map[int32]float32 `protobuf:"bytes,1,rep,name=sleeve_factors,json=sleeveFactors,proto3" json:"sleeve_factors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+}
+
+func (x *SetSleeveFactorResponse) Reset() {
+	*x = SetSleeveFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSleeveFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSleeveFactorResponse) ProtoMessage() {}
+
+func (x *SetSleeveFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSleeveFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetSleeveFactorResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetSleeveFactorResponse) GetSleeveFactors() map[int32]float32 {
+	if x != nil {
+		return x.SleeveFactors
+	}
+	return nil
+}
+
+type UpdateLabelAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias  *LabelAlias `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Delete bool        `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *UpdateLabelAliasRequest) Reset() {
+	*x = UpdateLabelAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLabelAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelAliasRequest) ProtoMessage() {}
+
+func (x *UpdateLabelAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelAliasRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelAliasRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateLabelAliasRequest) GetAlias() *LabelAlias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+func (x *UpdateLabelAliasRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+type UpdateLabelAliasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aliases []*LabelAlias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *UpdateLabelAliasResponse) Reset() {
+	*x = UpdateLabelAliasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLabelAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelAliasResponse) ProtoMessage() {}
+
+func (x *UpdateLabelAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelAliasResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLabelAliasResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateLabelAliasResponse) GetAliases() []*LabelAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type SetLabelOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Override *LabelOverride `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	Delete   bool           `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *SetLabelOverrideRequest) Reset() {
+	*x = SetLabelOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLabelOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLabelOverrideRequest) ProtoMessage() {}
+
+func (x *SetLabelOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLabelOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetLabelOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetLabelOverrideRequest) GetOverride() *LabelOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+func (x *SetLabelOverrideRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+type SetLabelOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overrides []*LabelOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (x *SetLabelOverrideResponse) Reset() {
+	*x = SetLabelOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLabelOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLabelOverrideResponse) ProtoMessage() {}
+
+func (x *SetLabelOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLabelOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetLabelOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetLabelOverrideResponse) GetOverrides() []*LabelOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Replaces any room with the same name
+	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// Removes the named room instead
+	Delete bool `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+type UpdateRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms []*Room `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+}
+
+func (x *UpdateRoomResponse) Reset() {
+	*x = UpdateRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomResponse) ProtoMessage() {}
+
+func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateRoomResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Matches the discogs release id
+	ReleaseId int32 `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// Matches on catalogue number, optionally restricted to a label
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Catno string `protobuf:"bytes,3,opt,name=catno,proto3" json:"catno,omitempty"`
+	// Fuzzy match against the artist, title and label
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of results to return, zero returns them all
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SearchRequest) GetReleaseId() int32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCatno() string {
+	if x != nil {
+		return x.Catno
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View *PlacementView `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Path *PhysicalPath  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// How well this matched, one is an exact match
+	Score float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Which part of the request this matched on
+	Matched string `protobuf:"bytes,4,opt,name=matched,proto3" json:"matched,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchResult) GetView() *PlacementView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+func (x *SearchResult) GetPath() *PhysicalPath {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetMatched() string {
+	if x != nil {
+		return x.Matched
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchLocateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceIds []int64 `protobuf:"varint,1,rep,packed,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`
+}
+
+func (x *BatchLocateRequest) Reset() {
+	*x = BatchLocateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchLocateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLocateRequest) ProtoMessage() {}
+
+func (x *BatchLocateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLocateRequest.ProtoReflect.Descriptor instead.
+func (*BatchLocateRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BatchLocateRequest) GetInstanceIds() []int64 {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+type PickGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Slot     int32         `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Path     *PhysicalPath `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The records to pull from this slot in shelf order
+	Picks []*PlacementView `protobuf:"bytes,4,rep,name=picks,proto3" json:"picks,omitempty"`
+}
+
+func (x *PickGroup) Reset() {
+	*x = PickGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PickGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickGroup) ProtoMessage() {}
+
+func (x *PickGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickGroup.ProtoReflect.Descriptor instead.
+func (*PickGroup) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PickGroup) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PickGroup) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PickGroup) GetPath() *PhysicalPath {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *PickGroup) GetPicks() []*PlacementView {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+type BatchLocateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Groups in the order they should be walked
+	Groups []*PickGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Instance ids which could not be found
+	Missing []int64 `protobuf:"varint,2,rep,packed,name=missing,proto3" json:"missing,omitempty"`
+}
+
+func (x *BatchLocateResponse) Reset() {
+	*x = BatchLocateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchLocateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLocateResponse) ProtoMessage() {}
+
+func (x *BatchLocateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLocateResponse.ProtoReflect.Descriptor instead.
+func (*BatchLocateResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BatchLocateResponse) GetGroups() []*PickGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *BatchLocateResponse) GetMissing() []int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type GetSlotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Slot     int32  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *GetSlotRequest) Reset() {
+	*x = GetSlotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotRequest) ProtoMessage() {}
+
+func (x *GetSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetSlotRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetSlotRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *GetSlotRequest) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type GetSlotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What should be in the slot in shelf order
+	Contents []*PlacementView `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	// The records at each end of the slot
+	First      *PlacementView `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Last       *PlacementView `protobuf:"bytes,3,opt,name=last,proto3" json:"last,omitempty"`
+	TotalWidth float32        `protobuf:"fixed32,4,opt,name=total_width,json=totalWidth,proto3" json:"total_width,omitempty"`
+	Capacity   float32        `protobuf:"fixed32,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Path       *PhysicalPath  `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetSlotResponse) Reset() {
+	*x = GetSlotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotResponse) ProtoMessage() {}
+
+func (x *GetSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlotResponse.ProtoReflect.Descriptor instead.
+func (*GetSlotResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetSlotResponse) GetContents() []*PlacementView {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *GetSlotResponse) GetFirst() *PlacementView {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *GetSlotResponse) GetLast() *PlacementView {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *GetSlotResponse) GetTotalWidth() float32 {
+	if x != nil {
+		return x.TotalWidth
+	}
+	return 0
+}
+
+func (x *GetSlotResponse) GetCapacity() float32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetSlotResponse) GetPath() *PhysicalPath {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type ExportOrganisationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ExportOrganisationRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=recordsorganiser.ExportOrganisationRequest_Format" json:"format,omitempty"`
+	// The locations to export, all of them if empty
+	Locations []string `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *ExportOrganisationRequest) Reset() {
+	*x = ExportOrganisationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganisationRequest) ProtoMessage() {}
+
+func (x *ExportOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExportOrganisationRequest) GetFormat() ExportOrganisationRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportOrganisationRequest_CSV
+}
+
+func (x *ExportOrganisationRequest) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type ExportOrganisationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *ExportOrganisationResponse) Reset() {
+	*x = ExportOrganisationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganisationResponse) ProtoMessage() {}
+
+func (x *ExportOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ExportOrganisationResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportOrganisationResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type OrganisationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Locations are matched on name, placements in the config are ignored
+	Locations  []*Location       `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Extractors []*LabelExtractor `protobuf:"bytes,2,rep,name=extractors,proto3" json:"extractors,omitempty"`
+	// Aliases are matched on label id, overrides on instance id
+	LabelAliases   []*LabelAlias    `protobuf:"bytes,3,rep,name=label_aliases,json=labelAliases,proto3" json:"label_aliases,omitempty"`
+	LabelOverrides []*LabelOverride `protobuf:"bytes,4,rep,name=label_overrides,json=labelOverrides,proto3" json:"label_overrides,omitempty"`
+}
+
+func (x *OrganisationConfig) Reset() {
+	*x = OrganisationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganisationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganisationConfig) ProtoMessage() {}
+
+func (x *OrganisationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganisationConfig.ProtoReflect.Descriptor instead.
+func (*OrganisationConfig) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *OrganisationConfig) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *OrganisationConfig) GetExtractors() []*LabelExtractor {
+	if x != nil {
+		return x.Extractors
+	}
+	return nil
+}
+
+func (x *OrganisationConfig) GetLabelAliases() []*LabelAlias {
+	if x != nil {
+		return x.LabelAliases
+	}
+	return nil
+}
+
+func (x *OrganisationConfig) GetLabelOverrides() []*LabelOverride {
+	if x != nil {
+		return x.LabelOverrides
+	}
+	return nil
+}
+
+type ApplyConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *OrganisationConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Work out the changes without saving them
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Remove locations, extractors, aliases and overrides which are not in the config
+	Prune bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ApplyConfigRequest) GetConfig() *OrganisationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyConfigRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type ApplyConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The changes made (or that would be made) to the organisation
+	Diff    []string `protobuf:"bytes,1,rep,name=diff,proto3" json:"diff,omitempty"`
+	Applied bool     `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *ApplyConfigResponse) Reset() {
+	*x = ApplyConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigResponse) ProtoMessage() {}
+
+func (x *ApplyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ApplyConfigResponse) GetDiff() []string {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ApplyConfigResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type FieldViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path to the bad field, e.g. locations[Main].folder_ids
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldViolation) ProtoMessage() {}
+
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FieldViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ValidateOrganisationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ValidateOrganisationRequest) Reset() {
+	*x = ValidateOrganisationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateOrganisationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOrganisationRequest) ProtoMessage() {}
+
+func (x *ValidateOrganisationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOrganisationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateOrganisationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{68}
+}
+
+type ValidateOrganisationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*FieldViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *ValidateOrganisationResponse) Reset() {
+	*x = ValidateOrganisationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateOrganisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOrganisationResponse) ProtoMessage() {}
+
+func (x *ValidateOrganisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOrganisationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateOrganisationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ValidateOrganisationResponse) GetViolations() []*FieldViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type CoverageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CoverageRequest) Reset() {
+	*x = CoverageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverageRequest) ProtoMessage() {}
+
+func (x *CoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverageRequest.ProtoReflect.Descriptor instead.
+func (*CoverageRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{70}
+}
+
+type FolderOverlap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId int32 `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	// The locations claiming the folder, the first of these is the one used
+	Locations []string `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *FolderOverlap) Reset() {
+	*x = FolderOverlap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderOverlap) ProtoMessage() {}
+
+func (x *FolderOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderOverlap.ProtoReflect.Descriptor instead.
+func (*FolderOverlap) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FolderOverlap) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *FolderOverlap) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type UnownedFolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId    int32   `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	InstanceIds []int64 `protobuf:"varint,2,rep,packed,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty"`
+}
+
+func (x *UnownedFolder) Reset() {
+	*x = UnownedFolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnownedFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnownedFolder) ProtoMessage() {}
+
+func (x *UnownedFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnownedFolder.ProtoReflect.Descriptor instead.
+func (*UnownedFolder) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UnownedFolder) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *UnownedFolder) GetInstanceIds() []int64 {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+type CoverageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overlaps []*FolderOverlap `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	// Folders which hold records but are claimed by no location
+	Unowned []*UnownedFolder `protobuf:"bytes,2,rep,name=unowned,proto3" json:"unowned,omitempty"`
+	// Records in the collection which are on no shelf, leaving out sold and digital records
+	Unplaced []int64 `protobuf:"varint,3,rep,packed,name=unplaced,proto3" json:"unplaced,omitempty"`
+	// Records missing from the cache which we did not look up, and so may be unplaced
+	Unchecked []int64 `protobuf:"varint,4,rep,packed,name=unchecked,proto3" json:"unchecked,omitempty"`
+}
+
+func (x *CoverageResponse) Reset() {
+	*x = CoverageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverageResponse) ProtoMessage() {}
+
+func (x *CoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverageResponse.ProtoReflect.Descriptor instead.
+func (*CoverageResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CoverageResponse) GetOverlaps() []*FolderOverlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+func (x *CoverageResponse) GetUnowned() []*UnownedFolder {
+	if x != nil {
+		return x.Unowned
+	}
+	return nil
+}
+
+func (x *CoverageResponse) GetUnplaced() []int64 {
+	if x != nil {
+		return x.Unplaced
+	}
+	return nil
+}
+
+func (x *CoverageResponse) GetUnchecked() []int64 {
+	if x != nil {
+		return x.Unchecked
+	}
+	return nil
+}
+
+type RenameLocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+}
+
+func (x *RenameLocationRequest) Reset() {
+	*x = RenameLocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameLocationRequest) ProtoMessage() {}
+
+func (x *RenameLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameLocationRequest.ProtoReflect.Descriptor instead.
+func (*RenameLocationRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RenameLocationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameLocationRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type RenameLocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *Location `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// The number of audit entries moved to the new name
+	AuditEntries int32 `protobuf:"varint,2,opt,name=audit_entries,json=auditEntries,proto3" json:"audit_entries,omitempty"`
+	// Set if the location was renamed but its audit entries could not be moved
+	AuditError string `protobuf:"bytes,3,opt,name=audit_error,json=auditError,proto3" json:"audit_error,omitempty"`
+}
+
+func (x *RenameLocationResponse) Reset() {
+	*x = RenameLocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameLocationResponse) ProtoMessage() {}
+
+func (x *RenameLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameLocationResponse.ProtoReflect.Descriptor instead.
+func (*RenameLocationResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RenameLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RenameLocationResponse) GetAuditEntries() int32 {
+	if x != nil {
+		return x.AuditEntries
+	}
+	return 0
+}
+
+func (x *RenameLocationResponse) GetAuditError() string {
+	if x != nil {
+		return x.AuditError
+	}
+	return ""
+}
+
+type ReorderLocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Locations listed go first, in this order; the rest follow in their current order.
+	// Where locations share a folder the earliest one owns it.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ReorderLocationsRequest) Reset() {
+	*x = ReorderLocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLocationsRequest) ProtoMessage() {}
+
+func (x *ReorderLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReorderLocationsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ReorderLocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ReorderLocationsResponse) Reset() {
+	*x = ReorderLocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderLocationsResponse) ProtoMessage() {}
+
+func (x *ReorderLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReorderLocationsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type SortDivergenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The locations to check, all if empty
+	Locations []string `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *SortDivergenceRequest) Reset() {
+	*x = SortDivergenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortDivergenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortDivergenceRequest) ProtoMessage() {}
+
+func (x *SortDivergenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortDivergenceRequest.ProtoReflect.Descriptor instead.
+func (*SortDivergenceRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SortDivergenceRequest) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type SortDivergence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Folder   int32  `protobuf:"varint,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	Position int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// The record placed here by the live sort, and by the cached sort
+	LiveInstanceId   int64 `protobuf:"varint,4,opt,name=live_instance_id,json=liveInstanceId,proto3" json:"live_instance_id,omitempty"`
+	CachedInstanceId int64 `protobuf:"varint,5,opt,name=cached_instance_id,json=cachedInstanceId,proto3" json:"cached_instance_id,omitempty"`
+}
+
+func (x *SortDivergence) Reset() {
+	*x = SortDivergence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortDivergence) ProtoMessage() {}
+
+func (x *SortDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortDivergence.ProtoReflect.Descriptor instead.
+func (*SortDivergence) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SortDivergence) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SortDivergence) GetFolder() int32 {
+	if x != nil {
+		return x.Folder
+	}
+	return 0
+}
+
+func (x *SortDivergence) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SortDivergence) GetLiveInstanceId() int64 {
+	if x != nil {
+		return x.LiveInstanceId
+	}
+	return 0
+}
+
+func (x *SortDivergence) GetCachedInstanceId() int64 {
+	if x != nil {
+		return x.CachedInstanceId
+	}
+	return 0
+}
+
+type SortDivergenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Divergences []*SortDivergence `protobuf:"bytes,1,rep,name=divergences,proto3" json:"divergences,omitempty"`
+	// The number of records checked
+	Checked int32 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// Records whose cache entry has no label key yet, left out of the check until the next organise
+	Stale []int64 `protobuf:"varint,3,rep,packed,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *SortDivergenceResponse) Reset() {
+	*x = SortDivergenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortDivergenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortDivergenceResponse) ProtoMessage() {}
+
+func (x *SortDivergenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortDivergenceResponse.ProtoReflect.Descriptor instead.
+func (*SortDivergenceResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SortDivergenceResponse) GetDivergences() []*SortDivergence {
+	if x != nil {
+		return x.Divergences
+	}
+	return nil
+}
+
+func (x *SortDivergenceResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *SortDivergenceResponse) GetStale() []int64 {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+type GetCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCacheRequest) Reset() {
+	*x = GetCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheRequest) ProtoMessage() {}
+
+func (x *GetCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheRequest) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{81}
+}
+
+type GetCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cache *SortingCache `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
+}
+
+func (x *GetCacheResponse) Reset() {
+	*x = GetCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_organise_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheResponse) ProtoMessage() {}
+
+func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organise_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheResponse) Descriptor() ([]byte, []int) {
+	return file_organise_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCacheResponse) GetCache() *SortingCache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+var File_organise_proto protoreflect.FileDescriptor
+
+var file_organise_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x78, 0x0a,
+	0x0b, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x15, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xf7, 0x04, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x66, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x66, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x6f, 0x78, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x6f, 0x78,
+	0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61,
+	0x74, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x22, 0x49, 0x0a, 0x0e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xb4, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65,
+	0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x29, 0x0a, 0x10, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x7a, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a,
+	0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66,
+	0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75,
+	0x6d, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0e, 0x61, 0x62, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x9b, 0x01, 0x0a, 0x0e, 0x53, 0x6c, 0x6f, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x54,
+	0x0a, 0x0c, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x4f, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x34, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x07, 0x73, 0x68, 0x65,
+	0x6c, 0x76, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x60,
+	0x0a, 0x0c, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x22, 0x6c, 0x0a, 0x09, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xae,
+	0x0e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72,
+	0x74, 0x12, 0x42, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x48, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x61,
+	0x72, 0x64, 0x47, 0x61, 0x70, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x6e, 0x6f, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70,
+	0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x14, 0x6f, 0x70, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x70, 0x74,
+	0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x3f, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a,
+	0x0f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x06, 0x69, 0x6e, 0x50, 0x6c, 0x61,
+	0x79, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x53, 0x69, 0x6d, 0x69, 0x6c,
+	0x61, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x18, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x54, 0x6f, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x6f, 0x72, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x73, 0x6c, 0x6f, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3e,
+	0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61,
+	0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01,
+	0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x59, 0x5f,
+	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x43, 0x41, 0x54, 0x4e, 0x4f, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x42, 0x59, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x42, 0x59, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x44,
+	0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x42, 0x59, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x04,
+	0x12, 0x12, 0x0a, 0x0e, 0x42, 0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54,
+	0x45, 0x4e, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x59, 0x5f, 0x49, 0x49, 0x44, 0x10, 0x06,
+	0x22, 0x30, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x51,
+	0x55, 0x49, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x10, 0x01, 0x22, 0x38, 0x0a, 0x06, 0x49, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x0c,
+	0x50, 0x4c, 0x41, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x02, 0x22, 0x41, 0x0a, 0x09,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x47,
+	0x49, 0x54, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x49, 0x58, 0x10, 0x03, 0x22,
+	0x86, 0x05, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x38,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x41,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x58, 0x0a, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x6c,
+	0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0c,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x61, 0x0a, 0x0d, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x22, 0x66, 0x0a, 0x0a, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x64, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x64, 0x64, 0x22, 0x47, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x6e, 0x6f, 0x77, 0x22, 0x94, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xff, 0x02,
+	0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61,
+	0x74, 0x6e, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x65, 0x65,
+	0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x65, 0x65, 0x76, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22,
+	0xe7, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0a, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x22, 0xb4, 0x03,
+	0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x64, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x22, 0x68, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x84,
+	0x02, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe1, 0x04, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a,
+	0x0d, 0x61, 0x64, 0x64, 0x5f, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x67, 0x61, 0x70, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x48, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x68, 0x61, 0x72, 0x64,
+	0x5f, 0x67, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x48, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70, 0x73, 0x12, 0x65, 0x0a, 0x10, 0x61,
+	0x64, 0x64, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72,
+	0x74, 0x73, 0x1a, 0x65, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x53,
+	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64,
+	0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x33, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x54, 0x65, 0x73, 0x74, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x77, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x6e,
+	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x43, 0x61,
+	0x74, 0x6e, 0x6f, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x6e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x53, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x42, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x36, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x4c, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x48, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x65,
+	0x65, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6c, 0x65, 0x65, 0x76,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x6c, 0x65,
+	0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x53, 0x6c,
+	0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x22, 0x57, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x22,
+	0x86, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x74, 0x6e, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x32,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x37,
+	0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x63, 0x6b,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x69, 0x63,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73,
+	0x22, 0x64, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x63, 0x6b, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xb0, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x29,
+	0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
+	0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x22, 0x53, 0x0a, 0x1a, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9d,
+	0x02, 0x0a, 0x12, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x40, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x41, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0e,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x81,
+	0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x60, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x55, 0x6e, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x6e, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6f, 0x77, 0x6e, 0x65, 0x64,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x75, 0x6e, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x08, 0x75, 0x6e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x96, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x17, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x35, 0x0a,
+	0x15, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x76,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x8c, 0x01, 0x0a, 0x16, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x69,
+	0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0b, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x11,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x32, 0x8a, 0x14, 0x0a, 0x10,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5c, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x0c, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x62, 0x0a, 0x0d, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x26, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0x21, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x12, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x08, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
+	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x29, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x29, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_organise_proto_rawDescOnce sync.Once
+	file_organise_proto_rawDescData = file_organise_proto_rawDesc
+)
+
+func file_organise_proto_rawDescGZIP() []byte {
+	file_organise_proto_rawDescOnce.Do(func() {
+		file_organise_proto_rawDescData = protoimpl.X.CompressGZIP(file_organise_proto_rawDescData)
+	})
+	return file_organise_proto_rawDescData
+}
+
+var file_organise_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_organise_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_organise_proto_goTypes = []interface{}{
+	(Location_Sorting)(0),                 // 0: recordsorganiser.Location.Sorting
+	(Location_Checking)(0),                // 1: recordsorganiser.Location.Checking
+	(Location_InPlay)(0),                  // 2: recordsorganiser.Location.InPlay
+	(Location_MediaType)(0),               // 3: recordsorganiser.Location.MediaType
+	(ExportOrganisationRequest_Format)(0), // 4: recordsorganiser.ExportOrganisationRequest.Format
+	(*Empty)(nil),                         // 5: recordsorganiser.Empty
+	(*SortMapping)(nil),                   // 6: recordsorganiser.SortMapping
+	(*CacheEntry)(nil),                    // 7: recordsorganiser.CacheEntry
+	(*LabelSortKey)(nil),                  // 8: recordsorganiser.LabelSortKey
+	(*SortingCache)(nil),                  // 9: recordsorganiser.SortingCache
+	(*LabelExtractor)(nil),                // 10: recordsorganiser.LabelExtractor
+	(*ReleasePlacement)(nil),              // 11: recordsorganiser.ReleasePlacement
+	(*Quota)(nil),                         // 12: recordsorganiser.Quota
+	(*SlotDefinition)(nil),                // 13: recordsorganiser.SlotDefinition
+	(*PhysicalSlot)(nil),                  // 14: recordsorganiser.PhysicalSlot
+	(*Shelf)(nil),                         // 15: recordsorganiser.Shelf
+	(*Unit)(nil),                          // 16: recordsorganiser.Unit
+	(*Room)(nil),                          // 17: recordsorganiser.Room
+	(*PhysicalPath)(nil),                  // 18: recordsorganiser.PhysicalPath
+	(*SizeRoute)(nil),                     // 19: recordsorganiser.SizeRoute
+	(*Location)(nil),                      // 20: recordsorganiser.Location
+	(*Organisation)(nil),                  // 21: recordsorganiser.Organisation
+	(*LabelOverride)(nil),                 // 22: recordsorganiser.LabelOverride
+	(*LabelAlias)(nil),                    // 23: recordsorganiser.LabelAlias
+	(*ProtectionRules)(nil),               // 24: recordsorganiser.ProtectionRules
+	(*AddLocationRequest)(nil),            // 25: recordsorganiser.AddLocationRequest
+	(*AddLocationResponse)(nil),           // 26: recordsorganiser.AddLocationResponse
+	(*GetOrganisationRequest)(nil),        // 27: recordsorganiser.GetOrganisationRequest
+	(*PlacementView)(nil),                 // 28: recordsorganiser.PlacementView
+	(*GetOrganisationResponse)(nil),       // 29: recordsorganiser.GetOrganisationResponse
+	(*LocateRequest)(nil),                 // 30: recordsorganiser.LocateRequest
+	(*LocateResponse)(nil),                // 31: recordsorganiser.LocateResponse
+	(*QuotaRequest)(nil),                  // 32: recordsorganiser.QuotaRequest
+	(*QuotaResponse)(nil),                 // 33: recordsorganiser.QuotaResponse
+	(*UpdateLocationRequest)(nil),         // 34: recordsorganiser.UpdateLocationRequest
+	(*UpdateLocationResponse)(nil),        // 35: recordsorganiser.UpdateLocationResponse
+	(*AddExtractorRequest)(nil),           // 36: recordsorganiser.AddExtractorRequest
+	(*AddExtractorResponse)(nil),          // 37: recordsorganiser.AddExtractorResponse
+	(*ListExtractorsRequest)(nil),         // 38: recordsorganiser.ListExtractorsRequest
+	(*ListExtractorsResponse)(nil),        // 39: recordsorganiser.ListExtractorsResponse
+	(*RemoveExtractorRequest)(nil),        // 40: recordsorganiser.RemoveExtractorRequest
+	(*RemoveExtractorResponse)(nil),       // 41: recordsorganiser.RemoveExtractorResponse
+	(*TestExtractorRequest)(nil),          // 42: recordsorganiser.TestExtractorRequest
+	(*ExtractedCatno)(nil),                // 43: recordsorganiser.ExtractedCatno
+	(*TestExtractorResponse)(nil),         // 44: recordsorganiser.TestExtractorResponse
+	(*UpdateProtectionRequest)(nil),       // 45: recordsorganiser.UpdateProtectionRequest
+	(*UpdateProtectionResponse)(nil),      // 46: recordsorganiser.UpdateProtectionResponse
+	(*AuditEntry)(nil),                    // 47: recordsorganiser.AuditEntry
+	(*AuditLog)(nil),                      // 48: recordsorganiser.AuditLog
+	(*QueryAuditRequest)(nil),             // 49: recordsorganiser.QueryAuditRequest
+	(*QueryAuditResponse)(nil),            // 50: recordsorganiser.QueryAuditResponse
+	(*SetSleeveFactorRequest)(nil),        // 51: recordsorganiser.SetSleeveFactorRequest
+	(*SetSleeveFactorResponse)(nil),       // 52: recordsorganiser.SetSleeveFactorResponse
+	(*UpdateLabelAliasRequest)(nil),       // 53: recordsorganiser.UpdateLabelAliasRequest
+	(*UpdateLabelAliasResponse)(nil),      // 54: recordsorganiser.UpdateLabelAliasResponse
+	(*SetLabelOverrideRequest)(nil),       // 55: recordsorganiser.SetLabelOverrideRequest
+	(*SetLabelOverrideResponse)(nil),      // 56: recordsorganiser.SetLabelOverrideResponse
+	(*UpdateRoomRequest)(nil),             // 57: recordsorganiser.UpdateRoomRequest
+	(*UpdateRoomResponse)(nil),            // 58: recordsorganiser.UpdateRoomResponse
+	(*SearchRequest)(nil),                 // 59: recordsorganiser.SearchRequest
+	(*SearchResult)(nil),                  // 60: recordsorganiser.SearchResult
+	(*SearchResponse)(nil),                // 61: recordsorganiser.SearchResponse
+	(*BatchLocateRequest)(nil),            // 62: recordsorganiser.BatchLocateRequest
+	(*PickGroup)(nil),                     // 63: recordsorganiser.PickGroup
+	(*BatchLocateResponse)(nil),           // 64: recordsorganiser.BatchLocateResponse
+	(*GetSlotRequest)(nil),                // 65: recordsorganiser.GetSlotRequest
+	(*GetSlotResponse)(nil),               // 66: recordsorganiser.GetSlotResponse
+	(*ExportOrganisationRequest)(nil),     // 67: recordsorganiser.ExportOrganisationRequest
+	(*ExportOrganisationResponse)(nil),    // 68: recordsorganiser.ExportOrganisationResponse
+	(*OrganisationConfig)(nil),            // 69: recordsorganiser.OrganisationConfig
+	(*ApplyConfigRequest)(nil),            // 70: recordsorganiser.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),           // 71: recordsorganiser.ApplyConfigResponse
+	(*FieldViolation)(nil),                // 72: recordsorganiser.FieldViolation
+	(*ValidateOrganisationRequest)(nil),   // 73: recordsorganiser.ValidateOrganisationRequest
+	(*ValidateOrganisationResponse)(nil),  // 74: recordsorganiser.ValidateOrganisationResponse
+	(*CoverageRequest)(nil),               // 75: recordsorganiser.CoverageRequest
+	(*FolderOverlap)(nil),                 // 76: recordsorganiser.FolderOverlap
+	(*UnownedFolder)(nil),                 // 77: recordsorganiser.UnownedFolder
+	(*CoverageResponse)(nil),              // 78: recordsorganiser.CoverageResponse
+	(*RenameLocationRequest)(nil),         // 79: recordsorganiser.RenameLocationRequest
+	(*RenameLocationResponse)(nil),        // 80: recordsorganiser.RenameLocationResponse
+	(*ReorderLocationsRequest)(nil),       // 81: recordsorganiser.ReorderLocationsRequest
+	(*ReorderLocationsResponse)(nil),      // 82: recordsorganiser.ReorderLocationsResponse
+	(*SortDivergenceRequest)(nil),         // 83: recordsorganiser.SortDivergenceRequest
+	(*SortDivergence)(nil),                // 84: recordsorganiser.SortDivergence
+	(*SortDivergenceResponse)(nil),        // 85: recordsorganiser.SortDivergenceResponse
+	(*GetCacheRequest)(nil),               // 86: recordsorganiser.GetCacheRequest
+	(*GetCacheResponse)(nil),              // 87: recordsorganiser.GetCacheResponse
+	nil,                                   // 88: recordsorganiser.CacheEntry.EntryEntry
+	nil,                                   // 89: recordsorganiser.Location.FolderOrderEntry
+	nil,                                   // 90: recordsorganiser.Location.FolderSortEntry
+	nil,                                   // 91: recordsorganiser.Location.HardGapEntry
+	nil,                                   // 92: recordsorganiser.Organisation.SleeveFactorsEntry
+	nil,                                   // 93: recordsorganiser.UpdateLocationRequest.AddFolderSortsEntry
+	nil,                                   // 94: recordsorganiser.SetSleeveFactorResponse.SleeveFactorsEntry
+	(*fieldmaskpb.FieldMask)(nil),         // 95: google.protobuf.FieldMask
+}
+var file_organise_proto_depIdxs = []int32{
+	88,  // 0: recordsorganiser.CacheEntry.entry:type_name -> recordsorganiser.CacheEntry.EntryEntry
+	8,   // 1: recordsorganiser.CacheEntry.label_key:type_name -> recordsorganiser.LabelSortKey
+	7,   // 2: recordsorganiser.SortingCache.cache:type_name -> recordsorganiser.CacheEntry
+	18,  // 3: recordsorganiser.SlotDefinition.physical:type_name -> recordsorganiser.PhysicalPath
+	14,  // 4: recordsorganiser.Shelf.slots:type_name -> recordsorganiser.PhysicalSlot
+	15,  // 5: recordsorganiser.Unit.shelves:type_name -> recordsorganiser.Shelf
+	16,  // 6: recordsorganiser.Room.units:type_name -> recordsorganiser.Unit
+	89,  // 7: recordsorganiser.Location.folder_order:type_name -> recordsorganiser.Location.FolderOrderEntry
+	90,  // 8: recordsorganiser.Location.folder_sort:type_name -> recordsorganiser.Location.FolderSortEntry
+	91,  // 9: recordsorganiser.Location.hard_gap:type_name -> recordsorganiser.Location.HardGapEntry
+	11,  // 10: recordsorganiser.Location.releases_location:type_name -> recordsorganiser.ReleasePlacement
+	0,   // 11: recordsorganiser.Location.sort:type_name -> recordsorganiser.Location.Sorting
+	12,  // 12: recordsorganiser.Location.quota:type_name -> recordsorganiser.Quota
+	1,   // 13: recordsorganiser.Location.checking:type_name -> recordsorganiser.Location.Checking
+	2,   // 14: recordsorganiser.Location.in_play:type_name -> recordsorganiser.Location.InPlay
+	3,   // 15: recordsorganiser.Location.media_type:type_name -> recordsorganiser.Location.MediaType
+	13,  // 16: recordsorganiser.Location.slot_definitions:type_name -> recordsorganiser.SlotDefinition
+	19,  // 17: recordsorganiser.Location.size_routes:type_name -> recordsorganiser.SizeRoute
+	20,  // 18: recordsorganiser.Organisation.locations:type_name -> recordsorganiser.Location
+	10,  // 19: recordsorganiser.Organisation.extractors:type_name -> recordsorganiser.LabelExtractor
+	6,   // 20: recordsorganiser.Organisation.sort_mappings:type_name -> recordsorganiser.SortMapping
+	24,  // 21: recordsorganiser.Organisation.protection:type_name -> recordsorganiser.ProtectionRules
+	92,  // 22: recordsorganiser.Organisation.sleeve_factors:type_name -> recordsorganiser.Organisation.SleeveFactorsEntry
+	17,  // 23: recordsorganiser.Organisation.rooms:type_name -> recordsorganiser.Room
+	23,  // 24: recordsorganiser.Organisation.label_aliases:type_name -> recordsorganiser.LabelAlias
+	22,  // 25: recordsorganiser.Organisation.label_overrides:type_name -> recordsorganiser.LabelOverride
+	20,  // 26: recordsorganiser.AddLocationRequest.add:type_name -> recordsorganiser.Location
+	21,  // 27: recordsorganiser.AddLocationResponse.now:type_name -> recordsorganiser.Organisation
+	20,  // 28: recordsorganiser.GetOrganisationRequest.locations:type_name -> recordsorganiser.Location
+	11,  // 29: recordsorganiser.PlacementView.placement:type_name -> recordsorganiser.ReleasePlacement
+	20,  // 30: recordsorganiser.GetOrganisationResponse.locations:type_name -> recordsorganiser.Location
+	28,  // 31: recordsorganiser.GetOrganisationResponse.placements:type_name -> recordsorganiser.PlacementView
+	20,  // 32: recordsorganiser.LocateResponse.found_location:type_name -> recordsorganiser.Location
+	18,  // 33: recordsorganiser.LocateResponse.path:type_name -> recordsorganiser.PhysicalPath
+	28,  // 34: recordsorganiser.LocateResponse.placement:type_name -> recordsorganiser.PlacementView
+	28,  // 35: recordsorganiser.LocateResponse.before:type_name -> recordsorganiser.PlacementView
+	28,  // 36: recordsorganiser.LocateResponse.after:type_name -> recordsorganiser.PlacementView
+	12,  // 37: recordsorganiser.QuotaResponse.quota:type_name -> recordsorganiser.Quota
+	20,  // 38: recordsorganiser.UpdateLocationRequest.update:type_name -> recordsorganiser.Location
+	95,  // 39: recordsorganiser.UpdateLocationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	93,  // 40: recordsorganiser.UpdateLocationRequest.add_folder_sorts:type_name -> recordsorganiser.UpdateLocationRequest.AddFolderSortsEntry
+	10,  // 41: recordsorganiser.AddExtractorRequest.extractor:type_name -> recordsorganiser.LabelExtractor
+	10,  // 42: recordsorganiser.ListExtractorsResponse.extractors:type_name -> recordsorganiser.LabelExtractor
+	10,  // 43: recordsorganiser.TestExtractorRequest.extractor:type_name -> recordsorganiser.LabelExtractor
+	43,  // 44: recordsorganiser.TestExtractorResponse.sorted:type_name -> recordsorganiser.ExtractedCatno
+	24,  // 45: recordsorganiser.UpdateProtectionResponse.rules:type_name -> recordsorganiser.ProtectionRules
+	47,  // 46: recordsorganiser.AuditLog.entries:type_name -> recordsorganiser.AuditEntry
+	47,  // 47: recordsorganiser.QueryAuditResponse.entries:type_name -> recordsorganiser.AuditEntry
+	94,  // 48: recordsorganiser.SetSleeveFactorResponse.sleeve_factors:type_name -> recordsorganiser.SetSleeveFactorResponse.SleeveFactorsEntry
+	23,  // 49: recordsorganiser.UpdateLabelAliasRequest.alias:type_name -> recordsorganiser.LabelAlias
+	23,  // 50: recordsorganiser.UpdateLabelAliasResponse.aliases:type_name -> recordsorganiser.LabelAlias
+	22,  // 51: recordsorganiser.SetLabelOverrideRequest.override:type_name -> recordsorganiser.LabelOverride
+	22,  // 52: recordsorganiser.SetLabelOverrideResponse.overrides:type_name -> recordsorganiser.LabelOverride
+	17,  // 53: recordsorganiser.UpdateRoomRequest.room:type_name -> recordsorganiser.Room
+	17,  // 54: recordsorganiser.UpdateRoomResponse.rooms:type_name -> recordsorganiser.Room
+	28,  // 55: recordsorganiser.SearchResult.view:type_name -> recordsorganiser.PlacementView
+	18,  // 56: recordsorganiser.SearchResult.path:type_name -> recordsorganiser.PhysicalPath
+	60,  // 57: recordsorganiser.SearchResponse.results:type_name -> recordsorganiser.SearchResult
+	18,  // 58: recordsorganiser.PickGroup.path:type_name -> recordsorganiser.PhysicalPath
+	28,  // 59: recordsorganiser.PickGroup.picks:type_name -> recordsorganiser.PlacementView
+	63,  // 60: recordsorganiser.BatchLocateResponse.groups:type_name -> recordsorganiser.PickGroup
+	28,  // 61: recordsorganiser.GetSlotResponse.contents:type_name -> recordsorganiser.PlacementView
+	28,  // 62: recordsorganiser.GetSlotResponse.first:type_name -> recordsorganiser.PlacementView
+	28,  // 63: recordsorganiser.GetSlotResponse.last:type_name -> recordsorganiser.PlacementView
+	18,  // 64: recordsorganiser.GetSlotResponse.path:type_name -> recordsorganiser.PhysicalPath
+	4,   // 65: recordsorganiser.ExportOrganisationRequest.format:type_name -> recordsorganiser.ExportOrganisationRequest.Format
+	20,  // 66: recordsorganiser.OrganisationConfig.locations:type_name -> recordsorganiser.Location
+	10,  // 67: recordsorganiser.OrganisationConfig.extractors:type_name -> recordsorganiser.LabelExtractor
+	23,  // 68: recordsorganiser.OrganisationConfig.label_aliases:type_name -> recordsorganiser.LabelAlias
+	22,  // 69: recordsorganiser.OrganisationConfig.label_overrides:type_name -> recordsorganiser.LabelOverride
+	69,  // 70: recordsorganiser.ApplyConfigRequest.config:type_name -> recordsorganiser.OrganisationConfig
+	72,  // 71: recordsorganiser.ValidateOrganisationResponse.violations:type_name -> recordsorganiser.FieldViolation
+	76,  // 72: recordsorganiser.CoverageResponse.overlaps:type_name -> recordsorganiser.FolderOverlap
+	77,  // 73: recordsorganiser.CoverageResponse.unowned:type_name -> recordsorganiser.UnownedFolder
+	20,  // 74: recordsorganiser.RenameLocationResponse.location:type_name -> recordsorganiser.Location
+	84,  // 75: recordsorganiser.SortDivergenceResponse.divergences:type_name -> recordsorganiser.SortDivergence
+	9,   // 76: recordsorganiser.GetCacheResponse.cache:type_name -> recordsorganiser.SortingCache
+	0,   // 77: recordsorganiser.Location.FolderSortEntry.value:type_name -> recordsorganiser.Location.Sorting
+	0,   // 78: recordsorganiser.UpdateLocationRequest.AddFolderSortsEntry.value:type_name -> recordsorganiser.Location.Sorting
+	25,  // 79: recordsorganiser.OrganiserService.AddLocation:input_type -> recordsorganiser.AddLocationRequest
+	27,  // 80: recordsorganiser.OrganiserService.GetOrganisation:input_type -> recordsorganiser.GetOrganisationRequest
+	34,  // 81: recordsorganiser.OrganiserService.UpdateLocation:input_type -> recordsorganiser.UpdateLocationRequest
+	30,  // 82: recordsorganiser.OrganiserService.Locate:input_type -> recordsorganiser.LocateRequest
+	32,  // 83: recordsorganiser.OrganiserService.GetQuota:input_type -> recordsorganiser.QuotaRequest
+	36,  // 84: recordsorganiser.OrganiserService.AddExtractor:input_type -> recordsorganiser.AddExtractorRequest
+	38,  // 85: recordsorganiser.OrganiserService.ListExtractors:input_type -> recordsorganiser.ListExtractorsRequest
+	40,  // 86: recordsorganiser.OrganiserService.RemoveExtractor:input_type -> recordsorganiser.RemoveExtractorRequest
+	42,  // 87: recordsorganiser.OrganiserService.TestExtractor:input_type -> recordsorganiser.TestExtractorRequest
+	86,  // 88: recordsorganiser.OrganiserService.GetCache:input_type -> recordsorganiser.GetCacheRequest
+	45,  // 89: recordsorganiser.OrganiserService.UpdateProtection:input_type -> recordsorganiser.UpdateProtectionRequest
+	49,  // 90: recordsorganiser.OrganiserService.QueryAudit:input_type -> recordsorganiser.QueryAuditRequest
+	51,  // 91: recordsorganiser.OrganiserService.SetSleeveFactor:input_type -> recordsorganiser.SetSleeveFactorRequest
+	57,  // 92: recordsorganiser.OrganiserService.UpdateRoom:input_type -> recordsorganiser.UpdateRoomRequest
+	59,  // 93: recordsorganiser.OrganiserService.Search:input_type -> recordsorganiser.SearchRequest
+	62,  // 94: recordsorganiser.OrganiserService.BatchLocate:input_type -> recordsorganiser.BatchLocateRequest
+	65,  // 95: recordsorganiser.OrganiserService.GetSlot:input_type -> recordsorganiser.GetSlotRequest
+	67,  // 96: recordsorganiser.OrganiserService.ExportOrganisation:input_type -> recordsorganiser.ExportOrganisationRequest
+	70,  // 97: recordsorganiser.OrganiserService.ApplyConfig:input_type -> recordsorganiser.ApplyConfigRequest
+	73,  // 98: recordsorganiser.OrganiserService.ValidateOrganisation:input_type -> recordsorganiser.ValidateOrganisationRequest
+	75,  // 99: recordsorganiser.OrganiserService.Coverage:input_type -> recordsorganiser.CoverageRequest
+	79,  // 100: recordsorganiser.OrganiserService.RenameLocation:input_type -> recordsorganiser.RenameLocationRequest
+	81,  // 101: recordsorganiser.OrganiserService.ReorderLocations:input_type -> recordsorganiser.ReorderLocationsRequest
+	83,  // 102: recordsorganiser.OrganiserService.SortDivergence:input_type -> recordsorganiser.SortDivergenceRequest
+	53,  // 103: recordsorganiser.OrganiserService.UpdateLabelAlias:input_type -> recordsorganiser.UpdateLabelAliasRequest
+	55,  // 104: recordsorganiser.OrganiserService.SetLabelOverride:input_type -> recordsorganiser.SetLabelOverrideRequest
+	26,  // 105: recordsorganiser.OrganiserService.AddLocation:output_type -> recordsorganiser.AddLocationResponse
+	29,  // 106: recordsorganiser.OrganiserService.GetOrganisation:output_type -> recordsorganiser.GetOrganisationResponse
+	35,  // 107: recordsorganiser.OrganiserService.UpdateLocation:output_type -> recordsorganiser.UpdateLocationResponse
+	31,  // 108: recordsorganiser.OrganiserService.Locate:output_type -> recordsorganiser.LocateResponse
+	33,  // 109: recordsorganiser.OrganiserService.GetQuota:output_type -> recordsorganiser.QuotaResponse
+	37,  // 110: recordsorganiser.OrganiserService.AddExtractor:output_type -> recordsorganiser.AddExtractorResponse
+	39,  // 111: recordsorganiser.OrganiserService.ListExtractors:output_type -> recordsorganiser.ListExtractorsResponse
+	41,  // 112: recordsorganiser.OrganiserService.RemoveExtractor:output_type -> recordsorganiser.RemoveExtractorResponse
+	44,  // 113: recordsorganiser.OrganiserService.TestExtractor:output_type -> recordsorganiser.TestExtractorResponse
+	87,  // 114: recordsorganiser.OrganiserService.GetCache:output_type -> recordsorganiser.GetCacheResponse
+	46,  // 115: recordsorganiser.OrganiserService.UpdateProtection:output_type -> recordsorganiser.UpdateProtectionResponse
+	50,  // 116: recordsorganiser.OrganiserService.QueryAudit:output_type -> recordsorganiser.QueryAuditResponse
+	52,  // 117: recordsorganiser.OrganiserService.SetSleeveFactor:output_type -> recordsorganiser.SetSleeveFactorResponse
+	58,  // 118: recordsorganiser.OrganiserService.UpdateRoom:output_type -> recordsorganiser.UpdateRoomResponse
+	61,  // 119: recordsorganiser.OrganiserService.Search:output_type -> recordsorganiser.SearchResponse
+	64,  // 120: recordsorganiser.OrganiserService.BatchLocate:output_type -> recordsorganiser.BatchLocateResponse
+	66,  // 121: recordsorganiser.OrganiserService.GetSlot:output_type -> recordsorganiser.GetSlotResponse
+	68,  // 122: recordsorganiser.OrganiserService.ExportOrganisation:output_type -> recordsorganiser.ExportOrganisationResponse
+	71,  // 123: recordsorganiser.OrganiserService.ApplyConfig:output_type -> recordsorganiser.ApplyConfigResponse
+	74,  // 124: recordsorganiser.OrganiserService.ValidateOrganisation:output_type -> recordsorganiser.ValidateOrganisationResponse
+	78,  // 125: recordsorganiser.OrganiserService.Coverage:output_type -> recordsorganiser.CoverageResponse
+	80,  // 126: recordsorganiser.OrganiserService.RenameLocation:output_type -> recordsorganiser.RenameLocationResponse
+	82,  // 127: recordsorganiser.OrganiserService.ReorderLocations:output_type -> recordsorganiser.ReorderLocationsResponse
+	85,  // 128: recordsorganiser.OrganiserService.SortDivergence:output_type -> recordsorganiser.SortDivergenceResponse
+	54,  // 129: recordsorganiser.OrganiserService.UpdateLabelAlias:output_type -> recordsorganiser.UpdateLabelAliasResponse
+	56,  // 130: recordsorganiser.OrganiserService.SetLabelOverride:output_type -> recordsorganiser.SetLabelOverrideResponse
+	105, // [105:131] is the sub-list for method output_type
+	79,  // [79:105] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
+}
+
+func init() { file_organise_proto_init() }
+func file_organise_proto_init() {
+	if File_organise_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_organise_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelSortKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortingCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelExtractor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleasePlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shelf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Unit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organisation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabelAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtectionRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrganisationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrganisationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddExtractorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddExtractorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExtractorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExtractorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExtractorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExtractorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestExtractorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractedCatno); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestExtractorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProtectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProtectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSleeveFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSleeveFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLabelAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLabelAliasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLabelOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLabelOverrideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCacheRequest.ProtoReflect.Descriptor instead.
-func (*GetCacheRequest) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{21}
-}
-
-type GetCacheResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Cache *SortingCache `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
-}
-
-func (x *GetCacheResponse) Reset() {
-	*x = GetCacheResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_organise_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetCacheResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCacheResponse) ProtoMessage() {}
-
-func (x *GetCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organise_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_organise_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCacheResponse.ProtoReflect.Descriptor instead.
-func (*GetCacheResponse) Descriptor() ([]byte, []int) {
-	return file_organise_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetCacheResponse) GetCache() *SortingCache {
-	if x != nil {
-		return x.Cache
-	}
-	return nil
-}
-
-var File_organise_proto protoreflect.FileDescriptor
-
-var file_organise_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
-	0x65, 0x72, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x78, 0x0a, 0x0b, 0x53,
-	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65,
-	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x61, 0x72, 0x6c,
-	0x69, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x6c,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61,
-	0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x61, 0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42,
-	0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32,
-	0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
-	0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x22, 0x49, 0x0a, 0x0e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x9e, 0x01,
-	0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65,
-	0x64, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x64,
-	0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0xb1,
-	0x01, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f,
-	0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x05, 0x73,
-	0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x73, 0x6c,
-	0x6f, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0e, 0x61,
-	0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x57,
-	0x69, 0x64, 0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xa3, 0x0d, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x66,
-	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x64,
-	0x65, 0x72, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
-	0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x64,
-	0x65, 0x72, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x53, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x67, 0x61,
-	0x70, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x68, 0x61, 0x72, 0x64, 0x47, 0x61, 0x70, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
-	0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12,
-	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x12, 0x2f, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x71, 0x75, 0x6f,
-	0x74, 0x61, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x11, 0x6f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67,
-	0x12, 0x3f, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
-	0x51, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x69, 0x6e, 0x5f,
-	0x70, 0x6c, 0x61, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x06, 0x69,
-	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x27, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x53,
-	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x18, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x73,
-	0x6c, 0x6f, 0x74, 0x73, 0x54, 0x6f, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x53, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x61,
-	0x72, 0x64, 0x47, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x59, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x43,
-	0x41, 0x54, 0x4e, 0x4f, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x59, 0x5f, 0x44, 0x41, 0x54,
-	0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x59, 0x5f,
-	0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17,
-	0x0a, 0x13, 0x42, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x4e,
-	0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x59, 0x5f, 0x4d, 0x4f,
-	0x56, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x59, 0x5f,
-	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x0a, 0x0a,
-	0x06, 0x42, 0x59, 0x5f, 0x49, 0x49, 0x44, 0x10, 0x06, 0x22, 0x30, 0x0a, 0x08, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x53, 0x54,
-	0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x06, 0x49,
-	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x50, 0x4c,
-	0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
-	0x4c, 0x41, 0x59, 0x10, 0x02, 0x22, 0x41, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x47, 0x49, 0x54, 0x41, 0x4c, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x4d, 0x49, 0x58, 0x10, 0x03, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x64, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x41,
-	0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
-	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x03, 0x6e, 0x6f, 0x77, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72,
-	0x67, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
-	0x72, 0x67, 0x52, 0x65, 0x73, 0x65, 0x74, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x0c, 0x51,
-	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66,
-	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x5f,
-	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f,
-	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x70,
-	0x69, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x2d, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65,
-	0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x90,
-	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x41,
-	0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x73, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x32, 0x95, 0x05, 0x0a, 0x10, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0b,
-	0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41,
-	0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x06, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1e, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x64, 0x64,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
-	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_organise_proto_rawDescOnce sync.Once
-	file_organise_proto_rawDescData = file_organise_proto_rawDesc
-)
-
-func file_organise_proto_rawDescGZIP() []byte {
-	file_organise_proto_rawDescOnce.Do(func() {
-		file_organise_proto_rawDescData = protoimpl.X.CompressGZIP(file_organise_proto_rawDescData)
-	})
-	return file_organise_proto_rawDescData
-}
-
-var file_organise_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_organise_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_organise_proto_goTypes = []interface{}{
-	(Location_Sorting)(0),           // 0: recordsorganiser.Location.Sorting
-	(Location_Checking)(0),          // 1: recordsorganiser.Location.Checking
-	(Location_InPlay)(0),            // 2: recordsorganiser.Location.InPlay
-	(Location_MediaType)(0),         // 3: recordsorganiser.Location.MediaType
-	(*Empty)(nil),                   // 4: recordsorganiser.Empty
-	(*SortMapping)(nil),             // 5: recordsorganiser.SortMapping
-	(*CacheEntry)(nil),              // 6: recordsorganiser.CacheEntry
-	(*SortingCache)(nil),            // 7: recordsorganiser.SortingCache
-	(*LabelExtractor)(nil),          // 8: recordsorganiser.LabelExtractor
-	(*ReleasePlacement)(nil),        // 9: recordsorganiser.ReleasePlacement
-	(*Quota)(nil),                   // 10: recordsorganiser.Quota
-	(*Location)(nil),                // 11: recordsorganiser.Location
-	(*Organisation)(nil),            // 12: recordsorganiser.Organisation
-	(*AddLocationRequest)(nil),      // 13: recordsorganiser.AddLocationRequest
-	(*AddLocationResponse)(nil),     // 14: recordsorganiser.AddLocationResponse
-	(*GetOrganisationRequest)(nil),  // 15: recordsorganiser.GetOrganisationRequest
-	(*GetOrganisationResponse)(nil), // 16: recordsorganiser.GetOrganisationResponse
-	(*LocateRequest)(nil),           // 17: recordsorganiser.LocateRequest
-	(*LocateResponse)(nil),          // 18: recordsorganiser.LocateResponse
-	(*QuotaRequest)(nil),            // 19: recordsorganiser.QuotaRequest
-	(*QuotaResponse)(nil),           // 20: recordsorganiser.QuotaResponse
-	(*UpdateLocationRequest)(nil),   // 21: recordsorganiser.UpdateLocationRequest
-	(*UpdateLocationResponse)(nil),  // 22: recordsorganiser.UpdateLocationResponse
-	(*AddExtractorRequest)(nil),     // 23: recordsorganiser.AddExtractorRequest
-	(*AddExtractorResponse)(nil),    // 24: recordsorganiser.AddExtractorResponse
-	(*GetCacheRequest)(nil),         // 25: recordsorganiser.GetCacheRequest
-	(*GetCacheResponse)(nil),        // 26: recordsorganiser.GetCacheResponse
-	nil,                             // 27: recordsorganiser.CacheEntry.EntryEntry
-	nil,                             // 28: recordsorganiser.Location.FolderOrderEntry
-	nil,                             // 29: recordsorganiser.Location.FolderSortEntry
-	nil,                             // 30: recordsorganiser.Location.HardGapEntry
-}
-var file_organise_proto_depIdxs = []int32{
-	27, // 0: recordsorganiser.CacheEntry.entry:type_name -> recordsorganiser.CacheEntry.EntryEntry
-	6,  // 1: recordsorganiser.SortingCache.cache:type_name -> recordsorganiser.CacheEntry
-	28, // 2: recordsorganiser.Location.folder_order:type_name -> recordsorganiser.Location.FolderOrderEntry
-	29, // 3: recordsorganiser.Location.folder_sort:type_name -> recordsorganiser.Location.FolderSortEntry
-	30, // 4: recordsorganiser.Location.hard_gap:type_name -> recordsorganiser.Location.HardGapEntry
-	9,  // 5: recordsorganiser.Location.releases_location:type_name -> recordsorganiser.ReleasePlacement
-	0,  // 6: recordsorganiser.Location.sort:type_name -> recordsorganiser.Location.Sorting
-	10, // 7: recordsorganiser.Location.quota:type_name -> recordsorganiser.Quota
-	1,  // 8: recordsorganiser.Location.checking:type_name -> recordsorganiser.Location.Checking
-	2,  // 9: recordsorganiser.Location.in_play:type_name -> recordsorganiser.Location.InPlay
-	3,  // 10: recordsorganiser.Location.media_type:type_name -> recordsorganiser.Location.MediaType
-	11, // 11: recordsorganiser.Organisation.locations:type_name -> recordsorganiser.Location
-	8,  // 12: recordsorganiser.Organisation.extractors:type_name -> recordsorganiser.LabelExtractor
-	5,  // 13: recordsorganiser.Organisation.sort_mappings:type_name -> recordsorganiser.SortMapping
-	11, // 14: recordsorganiser.AddLocationRequest.add:type_name -> recordsorganiser.Location
-	12, // 15: recordsorganiser.AddLocationResponse.now:type_name -> recordsorganiser.Organisation
-	11, // 16: recordsorganiser.GetOrganisationRequest.locations:type_name -> recordsorganiser.Location
-	11, // 17: recordsorganiser.GetOrganisationResponse.locations:type_name -> recordsorganiser.Location
-	11, // 18: recordsorganiser.LocateResponse.found_location:type_name -> recordsorganiser.Location
-	10, // 19: recordsorganiser.QuotaResponse.quota:type_name -> recordsorganiser.Quota
-	11, // 20: recordsorganiser.UpdateLocationRequest.update:type_name -> recordsorganiser.Location
-	8,  // 21: recordsorganiser.AddExtractorRequest.extractor:type_name -> recordsorganiser.LabelExtractor
-	7,  // 22: recordsorganiser.GetCacheResponse.cache:type_name -> recordsorganiser.SortingCache
-	0,  // 23: recordsorganiser.Location.FolderSortEntry.value:type_name -> recordsorganiser.Location.Sorting
-	13, // 24: recordsorganiser.OrganiserService.AddLocation:input_type -> recordsorganiser.AddLocationRequest
-	15, // 25: recordsorganiser.OrganiserService.GetOrganisation:input_type -> recordsorganiser.GetOrganisationRequest
-	21, // 26: recordsorganiser.OrganiserService.UpdateLocation:input_type -> recordsorganiser.UpdateLocationRequest
-	17, // 27: recordsorganiser.OrganiserService.Locate:input_type -> recordsorganiser.LocateRequest
-	19, // 28: recordsorganiser.OrganiserService.GetQuota:input_type -> recordsorganiser.QuotaRequest
-	23, // 29: recordsorganiser.OrganiserService.AddExtractor:input_type -> recordsorganiser.AddExtractorRequest
-	25, // 30: recordsorganiser.OrganiserService.GetCache:input_type -> recordsorganiser.GetCacheRequest
-	14, // 31: recordsorganiser.OrganiserService.AddLocation:output_type -> recordsorganiser.AddLocationResponse
-	16, // 32: recordsorganiser.OrganiserService.GetOrganisation:output_type -> recordsorganiser.GetOrganisationResponse
-	22, // 33: recordsorganiser.OrganiserService.UpdateLocation:output_type -> recordsorganiser.UpdateLocationResponse
-	18, // 34: recordsorganiser.OrganiserService.Locate:output_type -> recordsorganiser.LocateResponse
-	20, // 35: recordsorganiser.OrganiserService.GetQuota:output_type -> recordsorganiser.QuotaResponse
-	24, // 36: recordsorganiser.OrganiserService.AddExtractor:output_type -> recordsorganiser.AddExtractorResponse
-	26, // 37: recordsorganiser.OrganiserService.GetCache:output_type -> recordsorganiser.GetCacheResponse
-	31, // [31:38] is the sub-list for method output_type
-	24, // [24:31] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
-}
-
-func init() { file_organise_proto_init() }
-func file_organise_proto_init() {
-	if File_organise_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_organise_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		file_organise_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2204,8 +7777,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SortMapping); i {
+		file_organise_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2216,8 +7789,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CacheEntry); i {
+		file_organise_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchLocateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2228,8 +7801,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SortingCache); i {
+		file_organise_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PickGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2240,8 +7813,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabelExtractor); i {
+		file_organise_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchLocateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2252,8 +7825,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleasePlacement); i {
+		file_organise_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2264,8 +7837,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Quota); i {
+		file_organise_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2276,8 +7849,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Location); i {
+		file_organise_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOrganisationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2288,8 +7861,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organisation); i {
+		file_organise_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOrganisationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2300,8 +7873,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLocationRequest); i {
+		file_organise_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrganisationConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2312,8 +7885,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLocationResponse); i {
+		file_organise_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2324,8 +7897,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrganisationRequest); i {
+		file_organise_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2336,8 +7909,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrganisationResponse); i {
+		file_organise_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldViolation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2348,8 +7921,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocateRequest); i {
+		file_organise_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOrganisationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2360,8 +7933,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocateResponse); i {
+		file_organise_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOrganisationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2372,8 +7945,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuotaRequest); i {
+		file_organise_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoverageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2384,8 +7957,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuotaResponse); i {
+		file_organise_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderOverlap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2396,8 +7969,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLocationRequest); i {
+		file_organise_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnownedFolder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2408,8 +7981,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLocationResponse); i {
+		file_organise_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoverageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2420,8 +7993,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddExtractorRequest); i {
+		file_organise_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameLocationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2432,8 +8005,8 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddExtractorResponse); i {
+		file_organise_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameLocationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2444,7 +8017,67 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_organise_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderLocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderLocationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortDivergenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortDivergence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortDivergenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_organise_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCacheRequest); i {
 			case 0:
 				return &v.state
@@ -2456,7 +8089,7 @@ func file_organise_proto_init() {
 				return nil
 			}
 		}
-		file_organise_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_organise_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCacheResponse); i {
 			case 0:
 				return &v.state
@@ -2469,18 +8102,19 @@ func file_organise_proto_init() {
 			}
 		}
 	}
-	file_organise_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_organise_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*Quota_Slots)(nil),
 		(*Quota_Width)(nil),
 		(*Quota_AbsoluteWidth)(nil),
 	}
+	file_organise_proto_msgTypes[25].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_organise_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   27,
+			NumEnums:      5,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,7 +28,26 @@ type OrganiserServiceClient interface {
 	Locate(ctx context.Context, in *LocateRequest, opts ...grpc.CallOption) (*LocateResponse, error)
 	GetQuota(ctx context.Context, in *QuotaRequest, opts ...grpc.CallOption) (*QuotaResponse, error)
 	AddExtractor(ctx context.Context, in *AddExtractorRequest, opts ...grpc.CallOption) (*AddExtractorResponse, error)
+	ListExtractors(ctx context.Context, in *ListExtractorsRequest, opts ...grpc.CallOption) (*ListExtractorsResponse, error)
+	RemoveExtractor(ctx context.Context, in *RemoveExtractorRequest, opts ...grpc.CallOption) (*RemoveExtractorResponse, error)
+	TestExtractor(ctx context.Context, in *TestExtractorRequest, opts ...grpc.CallOption) (*TestExtractorResponse, error)
 	GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error)
+	UpdateProtection(ctx context.Context, in *UpdateProtectionRequest, opts ...grpc.CallOption) (*UpdateProtectionResponse, error)
+	QueryAudit(ctx context.Context, in *QueryAuditRequest, opts ...grpc.CallOption) (*QueryAuditResponse, error)
+	SetSleeveFactor(ctx context.Context, in *SetSleeveFactorRequest, opts ...grpc.CallOption) (*SetSleeveFactorResponse, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	BatchLocate(ctx context.Context, in *BatchLocateRequest, opts ...grpc.CallOption) (*BatchLocateResponse, error)
+	GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*GetSlotResponse, error)
+	ExportOrganisation(ctx context.Context, in *ExportOrganisationRequest, opts ...grpc.CallOption) (*ExportOrganisationResponse, error)
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
+	ValidateOrganisation(ctx context.Context, in *ValidateOrganisationRequest, opts ...grpc.CallOption) (*ValidateOrganisationResponse, error)
+	Coverage(ctx context.Context, in *CoverageRequest, opts ...grpc.CallOption) (*CoverageResponse, error)
+	RenameLocation(ctx context.Context, in *RenameLocationRequest, opts ...grpc.CallOption) (*RenameLocationResponse, error)
+	ReorderLocations(ctx context.Context, in *ReorderLocationsRequest, opts ...grpc.CallOption) (*ReorderLocationsResponse, error)
+	SortDivergence(ctx context.Context, in *SortDivergenceRequest, opts ...grpc.CallOption) (*SortDivergenceResponse, error)
+	UpdateLabelAlias(ctx context.Context, in *UpdateLabelAliasRequest, opts ...grpc.CallOption) (*UpdateLabelAliasResponse, error)
+	SetLabelOverride(ctx context.Context, in *SetLabelOverrideRequest, opts ...grpc.CallOption) (*SetLabelOverrideResponse, error)
 }
 
 type organiserServiceClient struct {
@@ -93,6 +112,33 @@ func (c *organiserServiceClient) AddExtractor(ctx context.Context, in *AddExtrac
 	return out, nil
 }
 
+func (c *organiserServiceClient) ListExtractors(ctx context.Context, in *ListExtractorsRequest, opts ...grpc.CallOption) (*ListExtractorsResponse, error) {
+	out := new(ListExtractorsResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/ListExtractors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) RemoveExtractor(ctx context.Context, in *RemoveExtractorRequest, opts ...grpc.CallOption) (*RemoveExtractorResponse, error) {
+	out := new(RemoveExtractorResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/RemoveExtractor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) TestExtractor(ctx context.Context, in *TestExtractorRequest, opts ...grpc.CallOption) (*TestExtractorResponse, error) {
+	out := new(TestExtractorResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/TestExtractor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organiserServiceClient) GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error) {
 	out := new(GetCacheResponse)
 	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/GetCache", in, out, opts...)
@@ -102,6 +148,150 @@ func (c *organiserServiceClient) GetCache(ctx context.Context, in *GetCacheReque
 	return out, nil
 }
 
+func (c *organiserServiceClient) UpdateProtection(ctx context.Context, in *UpdateProtectionRequest, opts ...grpc.CallOption) (*UpdateProtectionResponse, error) {
+	out := new(UpdateProtectionResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/UpdateProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) QueryAudit(ctx context.Context, in *QueryAuditRequest, opts ...grpc.CallOption) (*QueryAuditResponse, error) {
+	out := new(QueryAuditResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/QueryAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) SetSleeveFactor(ctx context.Context, in *SetSleeveFactorRequest, opts ...grpc.CallOption) (*SetSleeveFactorResponse, error) {
+	out := new(SetSleeveFactorResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/SetSleeveFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error) {
+	out := new(UpdateRoomResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/UpdateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) BatchLocate(ctx context.Context, in *BatchLocateRequest, opts ...grpc.CallOption) (*BatchLocateResponse, error) {
+	out := new(BatchLocateResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/BatchLocate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) GetSlot(ctx context.Context, in *GetSlotRequest, opts ...grpc.CallOption) (*GetSlotResponse, error) {
+	out := new(GetSlotResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/GetSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) ExportOrganisation(ctx context.Context, in *ExportOrganisationRequest, opts ...grpc.CallOption) (*ExportOrganisationResponse, error) {
+	out := new(ExportOrganisationResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/ExportOrganisation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/ApplyConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) ValidateOrganisation(ctx context.Context, in *ValidateOrganisationRequest, opts ...grpc.CallOption) (*ValidateOrganisationResponse, error) {
+	out := new(ValidateOrganisationResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/ValidateOrganisation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) Coverage(ctx context.Context, in *CoverageRequest, opts ...grpc.CallOption) (*CoverageResponse, error) {
+	out := new(CoverageResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/Coverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) RenameLocation(ctx context.Context, in *RenameLocationRequest, opts ...grpc.CallOption) (*RenameLocationResponse, error) {
+	out := new(RenameLocationResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/RenameLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) ReorderLocations(ctx context.Context, in *ReorderLocationsRequest, opts ...grpc.CallOption) (*ReorderLocationsResponse, error) {
+	out := new(ReorderLocationsResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/ReorderLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) SortDivergence(ctx context.Context, in *SortDivergenceRequest, opts ...grpc.CallOption) (*SortDivergenceResponse, error) {
+	out := new(SortDivergenceResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/SortDivergence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) UpdateLabelAlias(ctx context.Context, in *UpdateLabelAliasRequest, opts ...grpc.CallOption) (*UpdateLabelAliasResponse, error) {
+	out := new(UpdateLabelAliasResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/UpdateLabelAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organiserServiceClient) SetLabelOverride(ctx context.Context, in *SetLabelOverrideRequest, opts ...grpc.CallOption) (*SetLabelOverrideResponse, error) {
+	out := new(SetLabelOverrideResponse)
+	err := c.cc.Invoke(ctx, "/recordsorganiser.OrganiserService/SetLabelOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganiserServiceServer is the server API for OrganiserService service.
 // All implementations should embed UnimplementedOrganiserServiceServer
 // for forward compatibility
@@ -112,7 +302,26 @@ type OrganiserServiceServer interface {
 	Locate(context.Context, *LocateRequest) (*LocateResponse, error)
 	GetQuota(context.Context, *QuotaRequest) (*QuotaResponse, error)
 	AddExtractor(context.Context, *AddExtractorRequest) (*AddExtractorResponse, error)
+	ListExtractors(context.Context, *ListExtractorsRequest) (*ListExtractorsResponse, error)
+	RemoveExtractor(context.Context, *RemoveExtractorRequest) (*RemoveExtractorResponse, error)
+	TestExtractor(context.Context, *TestExtractorRequest) (*TestExtractorResponse, error)
 	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
+	UpdateProtection(context.Context, *UpdateProtectionRequest) (*UpdateProtectionResponse, error)
+	QueryAudit(context.Context, *QueryAuditRequest) (*QueryAuditResponse, error)
+	SetSleeveFactor(context.Context, *SetSleeveFactorRequest) (*SetSleeveFactorResponse, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	BatchLocate(context.Context, *BatchLocateRequest) (*BatchLocateResponse, error)
+	GetSlot(context.Context, *GetSlotRequest) (*GetSlotResponse, error)
+	ExportOrganisation(context.Context, *ExportOrganisationRequest) (*ExportOrganisationResponse, error)
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
+	ValidateOrganisation(context.Context, *ValidateOrganisationRequest) (*ValidateOrganisationResponse, error)
+	Coverage(context.Context, *CoverageRequest) (*CoverageResponse, error)
+	RenameLocation(context.Context, *RenameLocationRequest) (*RenameLocationResponse, error)
+	ReorderLocations(context.Context, *ReorderLocationsRequest) (*ReorderLocationsResponse, error)
+	SortDivergence(context.Context, *SortDivergenceRequest) (*SortDivergenceResponse, error)
+	UpdateLabelAlias(context.Context, *UpdateLabelAliasRequest) (*UpdateLabelAliasResponse, error)
+	SetLabelOverride(context.Context, *SetLabelOverrideRequest) (*SetLabelOverrideResponse, error)
 }
 
 // UnimplementedOrganiserServiceServer should be embedded to have forward compatible implementations.
@@ -137,9 +346,66 @@ func (UnimplementedOrganiserServiceServer) GetQuota(context.Context, *QuotaReque
 func (UnimplementedOrganiserServiceServer) AddExtractor(context.Context, *AddExtractorRequest) (*AddExtractorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddExtractor not implemented")
 }
+func (UnimplementedOrganiserServiceServer) ListExtractors(context.Context, *ListExtractorsRequest) (*ListExtractorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExtractors not implemented")
+}
+func (UnimplementedOrganiserServiceServer) RemoveExtractor(context.Context, *RemoveExtractorRequest) (*RemoveExtractorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExtractor not implemented")
+}
+func (UnimplementedOrganiserServiceServer) TestExtractor(context.Context, *TestExtractorRequest) (*TestExtractorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestExtractor not implemented")
+}
 func (UnimplementedOrganiserServiceServer) GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
 }
+func (UnimplementedOrganiserServiceServer) UpdateProtection(context.Context, *UpdateProtectionRequest) (*UpdateProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtection not implemented")
+}
+func (UnimplementedOrganiserServiceServer) QueryAudit(context.Context, *QueryAuditRequest) (*QueryAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAudit not implemented")
+}
+func (UnimplementedOrganiserServiceServer) SetSleeveFactor(context.Context, *SetSleeveFactorRequest) (*SetSleeveFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSleeveFactor not implemented")
+}
+func (UnimplementedOrganiserServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedOrganiserServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedOrganiserServiceServer) BatchLocate(context.Context, *BatchLocateRequest) (*BatchLocateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchLocate not implemented")
+}
+func (UnimplementedOrganiserServiceServer) GetSlot(context.Context, *GetSlotRequest) (*GetSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlot not implemented")
+}
+func (UnimplementedOrganiserServiceServer) ExportOrganisation(context.Context, *ExportOrganisationRequest) (*ExportOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOrganisation not implemented")
+}
+func (UnimplementedOrganiserServiceServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfig not implemented")
+}
+func (UnimplementedOrganiserServiceServer) ValidateOrganisation(context.Context, *ValidateOrganisationRequest) (*ValidateOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateOrganisation not implemented")
+}
+func (UnimplementedOrganiserServiceServer) Coverage(context.Context, *CoverageRequest) (*CoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Coverage not implemented")
+}
+func (UnimplementedOrganiserServiceServer) RenameLocation(context.Context, *RenameLocationRequest) (*RenameLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameLocation not implemented")
+}
+func (UnimplementedOrganiserServiceServer) ReorderLocations(context.Context, *ReorderLocationsRequest) (*ReorderLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderLocations not implemented")
+}
+func (UnimplementedOrganiserServiceServer) SortDivergence(context.Context, *SortDivergenceRequest) (*SortDivergenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortDivergence not implemented")
+}
+func (UnimplementedOrganiserServiceServer) UpdateLabelAlias(context.Context, *UpdateLabelAliasRequest) (*UpdateLabelAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabelAlias not implemented")
+}
+func (UnimplementedOrganiserServiceServer) SetLabelOverride(context.Context, *SetLabelOverrideRequest) (*SetLabelOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLabelOverride not implemented")
+}
 
 // UnsafeOrganiserServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OrganiserServiceServer will
@@ -260,6 +526,60 @@ func _OrganiserService_AddExtractor_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganiserService_ListExtractors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExtractorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).ListExtractors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/ListExtractors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).ListExtractors(ctx, req.(*ListExtractorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_RemoveExtractor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveExtractorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).RemoveExtractor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/RemoveExtractor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).RemoveExtractor(ctx, req.(*RemoveExtractorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_TestExtractor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestExtractorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).TestExtractor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/TestExtractor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).TestExtractor(ctx, req.(*TestExtractorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrganiserService_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCacheRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +598,294 @@ func _OrganiserService_GetCache_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganiserService_UpdateProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).UpdateProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/UpdateProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).UpdateProtection(ctx, req.(*UpdateProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_QueryAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).QueryAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/QueryAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).QueryAudit(ctx, req.(*QueryAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_SetSleeveFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSleeveFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).SetSleeveFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/SetSleeveFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).SetSleeveFactor(ctx, req.(*SetSleeveFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/UpdateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_BatchLocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchLocateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).BatchLocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/BatchLocate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).BatchLocate(ctx, req.(*BatchLocateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_GetSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).GetSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/GetSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).GetSlot(ctx, req.(*GetSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_ExportOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).ExportOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/ExportOrganisation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).ExportOrganisation(ctx, req.(*ExportOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/ApplyConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_ValidateOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).ValidateOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/ValidateOrganisation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).ValidateOrganisation(ctx, req.(*ValidateOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_Coverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).Coverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/Coverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).Coverage(ctx, req.(*CoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_RenameLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).RenameLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/RenameLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).RenameLocation(ctx, req.(*RenameLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_ReorderLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).ReorderLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/ReorderLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).ReorderLocations(ctx, req.(*ReorderLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_SortDivergence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortDivergenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).SortDivergence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/SortDivergence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).SortDivergence(ctx, req.(*SortDivergenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_UpdateLabelAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).UpdateLabelAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/UpdateLabelAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).UpdateLabelAlias(ctx, req.(*UpdateLabelAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganiserService_SetLabelOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganiserServiceServer).SetLabelOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recordsorganiser.OrganiserService/SetLabelOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganiserServiceServer).SetLabelOverride(ctx, req.(*SetLabelOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrganiserService_ServiceDesc is the grpc.ServiceDesc for OrganiserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,10 +917,86 @@ var OrganiserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddExtractor",
 			Handler:    _OrganiserService_AddExtractor_Handler,
 		},
+		{
+			MethodName: "ListExtractors",
+			Handler:    _OrganiserService_ListExtractors_Handler,
+		},
+		{
+			MethodName: "RemoveExtractor",
+			Handler:    _OrganiserService_RemoveExtractor_Handler,
+		},
+		{
+			MethodName: "TestExtractor",
+			Handler:    _OrganiserService_TestExtractor_Handler,
+		},
 		{
 			MethodName: "GetCache",
 			Handler:    _OrganiserService_GetCache_Handler,
 		},
+		{
+			MethodName: "UpdateProtection",
+			Handler:    _OrganiserService_UpdateProtection_Handler,
+		},
+		{
+			MethodName: "QueryAudit",
+			Handler:    _OrganiserService_QueryAudit_Handler,
+		},
+		{
+			MethodName: "SetSleeveFactor",
+			Handler:    _OrganiserService_SetSleeveFactor_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _OrganiserService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _OrganiserService_Search_Handler,
+		},
+		{
+			MethodName: "BatchLocate",
+			Handler:    _OrganiserService_BatchLocate_Handler,
+		},
+		{
+			MethodName: "GetSlot",
+			Handler:    _OrganiserService_GetSlot_Handler,
+		},
+		{
+			MethodName: "ExportOrganisation",
+			Handler:    _OrganiserService_ExportOrganisation_Handler,
+		},
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _OrganiserService_ApplyConfig_Handler,
+		},
+		{
+			MethodName: "ValidateOrganisation",
+			Handler:    _OrganiserService_ValidateOrganisation_Handler,
+		},
+		{
+			MethodName: "Coverage",
+			Handler:    _OrganiserService_Coverage_Handler,
+		},
+		{
+			MethodName: "RenameLocation",
+			Handler:    _OrganiserService_RenameLocation_Handler,
+		},
+		{
+			MethodName: "ReorderLocations",
+			Handler:    _OrganiserService_ReorderLocations_Handler,
+		},
+		{
+			MethodName: "SortDivergence",
+			Handler:    _OrganiserService_SortDivergence_Handler,
+		},
+		{
+			MethodName: "UpdateLabelAlias",
+			Handler:    _OrganiserService_UpdateLabelAlias_Handler,
+		},
+		{
+			MethodName: "SetLabelOverride",
+			Handler:    _OrganiserService_SetLabelOverride_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organise.proto",
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"sync"
 	"time"
@@ -27,10 +28,18 @@ import (
 type Server struct {
 	*goserver.GoServer
 	bridge discogsBridge
+	store  dataStore
+}
+
+// dataStore holds the data we keep outside the keystore, like the sorting cache
+type dataStore interface {
+	LoadData(ctx context.Context, key string, consensus float32) ([]byte, error)
+	SaveData(ctx context.Context, data []byte, key string, consensus float32) error
 }
 
 type discogsBridge interface {
 	getReleases(ctx context.Context, folders []int32) ([]int64, error)
+	getAllReleases(ctx context.Context) ([]int64, error)
 	getRecord(ctx context.Context, instanceID int64) (*pbrc.Record, error)
 	updateRecord(ctx context.Context, req *pbrc.UpdateRecordRequest) (*pbrc.UpdateRecordsResponse, error)
 }
@@ -43,19 +52,31 @@ func convert(exs []*pb.LabelExtractor) map[int32]string {
 	return m
 }
 
-func (s *Server) markOverQuota(ctx context.Context, c *pb.Location) error {
+// compileExtractors compiles each label's extractor once for a sort; extractors which don't
+// compile or have no capture group are left out
+func compileExtractors(exs map[int32]string) map[int32]*regexp.Regexp {
+	m := make(map[int32]*regexp.Regexp)
+	for label, ex := range exs {
+		if r, err := regexp.Compile(ex); err == nil && r.NumSubexp() > 0 {
+			m[label] = r
+		}
+	}
+	return m
+}
+
+func (s *Server) markOverQuota(ctx context.Context, c *pb.Location, rules *pb.ProtectionRules, est *widthEstimator) error {
 	if c.GetQuota().GetSlots() > 0 {
-		return s.processSlotQuota(ctx, c)
+		return s.processSlotQuota(ctx, c, rules)
 	}
 
 	if c.GetQuota().GetAbsoluteWidth() > 0 {
-		return s.processAbsoluteWidthQuota(ctx, c)
+		return s.processAbsoluteWidthQuota(ctx, c, rules)
 	}
 
 	if c.GetQuota().GetTotalWidth() > 0 {
-		return s.processWidthQuota(ctx, c)
+		return s.processWidthQuota(ctx, c, rules, est)
 	}
-	return s.processQuota(ctx, c)
+	return s.processQuota(ctx, c, rules)
 }
 
 var (
@@ -69,6 +90,11 @@ var (
 		Help: "Widthof slots",
 	}, []string{"location", "slot"})
 
+	scapacity = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "recordsorganiser_slot_capacity",
+		Help: "Capacity of slots",
+	}, []string{"location", "slot"})
+
 	twidth = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "recordsorganiser_total_width",
 		Help: "Widthof slots",
@@ -122,6 +148,8 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 	fwidths := []float64{1}
 	tw := make(map[int64]string)
 	fw := make(map[int64]int32)
+	extractors := compileExtractors(convert(org.GetExtractors()))
+	rules := newLabelRules(org)
 	maxorder := int32(0)
 	for _, ord := range c.GetFolderOrder() {
 		if ord > maxorder {
@@ -196,7 +224,7 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 		for _, r := range tfr {
 			keepCount[fmt.Sprintf("%v", r.GetMetadata().GetKeep())]++
 			id := r.GetRelease().GetInstanceId()
-			entry := appendCache(cache, r)
+			entry := appendCache(cache, r, extractors, rules)
 			widths[id] = entry.GetWidth()
 
 			if widths[id] > 0 {
@@ -219,23 +247,12 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 		case pb.Location_BY_DATE_ADDED:
 			sort.Sort(ByDateAdded(tfr))
 		case pb.Location_BY_LABEL_CATNO:
-			sort.Sort(ByLabelCat{tfr, convert(org.GetExtractors()), s.CtxLog, cache})
-			sort.Sort(ByCachedLabelCat{tfr2, cache})
-
-			count := 0
-			for i := range tfr {
-				if tfr[i].GetRelease().GetInstanceId() != tfr2[i] {
-					align.With(prometheus.Labels{"location": c.GetName()}).Inc()
-					count++
-					counts1 := "Aligning on " + c.GetName() + "\n"
-					if i > 0 {
-						counts1 += fmt.Sprintf("%v vs %v\n", tfr[i-1].GetRelease().GetInstanceId(), tfr2[i-1])
-					}
-					counts1 += fmt.Sprintf("%v vs %v\n", tfr[i].GetRelease().GetInstanceId(), tfr2[i])
-					if i+1 < len(tfr) {
-						counts1 += fmt.Sprintf("%v vs %v\n", tfr[i+1].GetRelease().GetInstanceId(), tfr2[i+1])
-					}
-				}
+			sortByLabel(tfr, extractors, rules)
+			sortCachedByLabel(tfr2, cache)
+
+			for _, d := range sortDivergences(tfr, tfr2) {
+				align.With(prometheus.Labels{"location": c.GetName()}).Inc()
+				s.CtxLog(ctx, fmt.Sprintf("Aligning on %v at %v: %v vs %v", c.GetName(), d.GetPosition(), d.GetLiveInstanceId(), d.GetCachedInstanceId()))
 			}
 		case pb.Location_BY_FOLDER_THEN_DATE:
 			sort.Sort(ByFolderThenRelease(tfr))
@@ -263,30 +280,45 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 	overall := noverall
 	var mapper map[int64][]*rcpb.Record
 	if c.CombineSimilar {
-		overall, mapper = s.collapse(ctx, noverall, cache)
+		overall, mapper = s.collapse(ctx, noverall, cache, org.GetSleeveFactors(), rules)
 	}
 
+	est := newWidthEstimator(cache, org.GetSleeveFactors(), rules)
 	awidth.With(prometheus.Labels{"location": c.GetName()}).Set(float64(fwidths[len(fwidths)/2]))
-	records := s.Split(ctx, c.GetName(), overall, float32(c.GetSlots()), float32(c.GetQuota().GetTotalWidth()), gaps, c.GetAllowAdjust(), fwidths[len(fwidths)/2])
-
 	total := float32(0)
 	c.ReleasesLocation = []*pb.ReleasePlacement{}
-	for slot, recs := range records {
-		for i, rinloc := range expand(recs, mapper) {
-			sl := slot + 1
-			if sl < mslot[rinloc.GetRelease().GetFolderId()] {
-				mslot[rinloc.GetRelease().GetFolderId()] = sl
-			}
-			c.ReleasesLocation = append(c.ReleasesLocation,
-				&pb.ReleasePlacement{
-					Slot:            int32(slot + 1),
-					Index:           int32(i),
-					InstanceId:      rinloc.GetRelease().InstanceId,
-					Title:           rinloc.GetRelease().Title,
-					DeterminedWidth: getFormatWidth(rinloc, fwidths[len(fwidths)/2])})
-			total += getFormatWidth(rinloc, fwidths[len(fwidths)/2])
+	offset := 0
+	for _, group := range routeBySize(c, overall, gaps) {
+		if len(group.records) == 0 {
+			continue
+		}
+		if int(group.route.GetStartSlot())-1 > offset {
+			offset = int(group.route.GetStartSlot()) - 1
 		}
 
+		records := s.Split(ctx, c, group.records, group.gaps, est, offset)
+		for slot, recs := range records {
+			sl := offset + slot + 1
+			for i, rinloc := range expand(recs, mapper) {
+				if sl < mslot[rinloc.GetRelease().GetFolderId()] {
+					mslot[rinloc.GetRelease().GetFolderId()] = sl
+				}
+				_, confidence := est.estimate(rinloc)
+				c.ReleasesLocation = append(c.ReleasesLocation,
+					&pb.ReleasePlacement{
+						Slot:            int32(sl),
+						Index:           int32(i),
+						InstanceId:      rinloc.GetRelease().InstanceId,
+						Title:           rinloc.GetRelease().Title,
+						DeterminedWidth: est.width(rinloc),
+						EstimatedWidth:  rinloc.GetMetadata().GetRecordWidth() <= 0,
+						WidthConfidence: confidence,
+						SizeClass:       sizeClass(rinloc),
+						SubLocation:     group.route.GetSubLocation()})
+				total += est.width(rinloc)
+			}
+		}
+		offset += len(records)
 	}
 
 	for folder, mi := range mslot {
@@ -297,7 +329,7 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 
 	//Make any quota adjustments - we only do width ajdustments
 	if c.GetQuota().GetAbsoluteWidth() > 0 || c.GetQuota().GetSlots() > 0 {
-		s.markOverQuota(ctx, c)
+		s.markOverQuota(ctx, c, org.GetProtection(), est)
 	}
 
 	slotWidths := make(map[int]float64)
@@ -322,6 +354,9 @@ func (s *Server) organiseLocation(ctx context.Context, cache *pb.SortingCache, c
 	for slot := maxSlot + 1; slot < 100; slot++ {
 		swidths.With(prometheus.Labels{"location": c.GetName(), "slot": fmt.Sprintf("%v", slot)}).Set(0)
 	}
+	for slot := 1; slot <= numSlots(c); slot++ {
+		scapacity.With(prometheus.Labels{"location": c.GetName(), "slot": fmt.Sprintf("%v", slot)}).Set(float64(slotCapacity(c, slot)))
+	}
 
 	for key, val := range tc {
 		tcount.With(prometheus.Labels{"location": c.GetName(), "state": key}).Set(val)
@@ -373,7 +408,7 @@ const (
 )
 
 func (s *Server) loadCache(ctx context.Context) (*pb.SortingCache, error) {
-	data, err := s.LoadData(ctx, CACHE_KEY, 0.5)
+	data, err := s.store.LoadData(ctx, CACHE_KEY, 0.5)
 	if err != nil {
 		if status.Convert(err).Code() == codes.InvalidArgument {
 			return &pb.SortingCache{}, nil
@@ -393,12 +428,23 @@ func (s *Server) loadCache(ctx context.Context) (*pb.SortingCache, error) {
 	return cache, nil
 }
 
+// displayCache loads the cache for requests which only take display details from it; the
+// placements don't need it, so a failed load is logged and those details are left out
+func (s *Server) displayCache(ctx context.Context) *pb.SortingCache {
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		s.CtxLog(ctx, fmt.Sprintf("Unable to load cache for display: %v", err))
+		return &pb.SortingCache{}
+	}
+	return cache
+}
+
 func (s *Server) saveCache(ctx context.Context, config *pb.SortingCache) error {
 	data, err := proto.Marshal(config)
 	if err != nil {
 		return err
 	}
-	return s.SaveData(ctx, data, CACHE_KEY, 0.5)
+	return s.store.SaveData(ctx, data, CACHE_KEY, 0.5)
 }
 
 func (s *Server) readOrg(ctx context.Context) (*pb.Organisation, error) {
@@ -450,6 +496,10 @@ func (s *Server) readOrg(ctx context.Context) (*pb.Organisation, error) {
 		}
 	}
 
+	if len(org.GetSleeveFactors()) == 0 {
+		org.SleeveFactors = defaultSleeveFactors()
+	}
+
 	return org, nil
 }
 
@@ -505,6 +555,21 @@ func (discogsBridge prodBridge) getReleases(ctx context.Context, folders []int32
 	return result, nil
 }
 
+func (discogsBridge prodBridge) getAllReleases(ctx context.Context) ([]int64, error) {
+	conn, err := discogsBridge.dial(ctx, "recordcollection")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := pbrc.NewRecordCollectionServiceClient(conn)
+
+	rel, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_All{All: true}})
+	if err != nil {
+		return nil, err
+	}
+	return rel.GetInstanceIds(), nil
+}
+
 // DoRegister does RPC registration
 func (s *Server) DoRegister(server *grpc.Server) {
 	pb.RegisterOrganiserServiceServer(server, s)
@@ -531,9 +596,10 @@ func (s Server) GetState() []*pbgs.State {
 // InitServer builds an initial server
 func InitServer() *Server {
 	server := &Server{
-		&goserver.GoServer{},
-		prodBridge{},
+		GoServer: &goserver.GoServer{},
+		bridge:   prodBridge{},
 	}
+	server.store = server.GoServer
 
 	return server
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"flag"
 	"fmt"
@@ -13,9 +14,13 @@ import (
 
 	"github.com/brotherlogic/goserver/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	pbgd "github.com/brotherlogic/godiscogs/proto"
 	pbrc "github.com/brotherlogic/recordcollection/proto"
+	"github.com/brotherlogic/recordsorganiser/labels"
 	"github.com/brotherlogic/recordsorganiser/locator"
 	pb "github.com/brotherlogic/recordsorganiser/proto"
 
@@ -23,20 +28,6 @@ import (
 	_ "google.golang.org/grpc/encoding/gzip"
 )
 
-func isTenInch(release *pbgd.Release) bool {
-	for _, format := range release.GetFormats() {
-		if format.GetName() == "10\"" {
-			return true
-		}
-		for _, desc := range format.GetDescriptions() {
-			if desc == "10\"" {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 // ByReleaseDate sorts by the given release date
 // but puts matched records up front and keepers in the rear
 type ByReleaseDate []*pbrc.Record
@@ -58,122 +49,93 @@ func (a ByReleaseDate) Less(i, j int) bool {
 	return strings.Compare(a[i].GetRelease().Title, a[j].GetRelease().Title) < 0
 }
 
-func locateRelease(ctx context.Context, c pb.OrganiserServiceClient, id int32) {
-	conn, err := utils.LFDialServer(ctx, "recordcollection")
-
-	if err != nil {
-		log.Fatalf("Unable to dial: %v", err)
-	}
-	defer conn.Close()
-
-	client := pbrc.NewRecordCollectionServiceClient(conn)
-	ids, err := client.QueryRecords(ctx, &pbrc.QueryRecordsRequest{Query: &pbrc.QueryRecordsRequest_ReleaseId{int32(id)}})
+func locateRelease(ctx context.Context, c pb.OrganiserServiceClient, req *pb.SearchRequest, neighbours int32) {
+	res, err := c.Search(ctx, req)
 	if err != nil {
-		log.Fatalf("Unable to get record %v -> %v", id, err)
+		log.Fatalf("Unable to search for %v -> %v", req, err)
 	}
 
-	if len(ids.GetInstanceIds()) == 0 {
-		fmt.Printf("No records with that id\n")
+	if len(res.GetResults()) == 0 {
+		fmt.Printf("No records matching that search\n")
 	}
 
-	for _, id := range ids.GetInstanceIds() {
-		location, err := c.Locate(ctx, &pb.LocateRequest{InstanceId: id})
+	for _, result := range res.GetResults() {
+		id := result.GetView().GetPlacement().GetInstanceId()
+		fmt.Printf("[%.2f on %v] ", result.GetScore(), result.GetMatched())
+		location, err := c.Locate(ctx, &pb.LocateRequest{InstanceId: id, Neighbours: proto.Int32(neighbours)})
 		if err != nil {
 			fmt.Printf("Unable to locate instance (%v) of %v because %v\n", id, id, err)
 		} else {
-			rec, err := client.GetRecord(ctx, &pbrc.GetRecordRequest{InstanceId: id})
-			if err != nil {
-				fmt.Printf("Error getting record: %v", err)
-				return
+			fmt.Printf("%v (%v) is in %v\n", location.GetPlacement().GetPlacement().GetTitle(), id, location.GetFoundLocation().GetName())
+			fmt.Printf("Slot %v (%v from the start, %v from the end)\n", location.GetPlacement().GetPlacement().GetSlot(), location.GetDistanceFromStart(), location.GetDistanceFromEnd())
+			for _, view := range location.GetBefore() {
+				fmt.Printf("  %v\n", getReleaseString(view, false, false))
 			}
-			fmt.Printf("%v (%v) is in %v\n", rec.GetRecord().GetRelease().Title, rec.GetRecord().GetRelease().InstanceId, location.GetFoundLocation().GetName())
-
-			for i, r := range location.GetFoundLocation().GetReleasesLocation() {
-				if r.GetInstanceId() == id {
-					fmt.Printf("Slot %v\n", r.GetSlot())
-					if i > 0 {
-						fmt.Printf("%v. %v\n", i-1, getReleaseString(ctx, location.GetFoundLocation().GetReleasesLocation()[i-1], false, false))
-					}
-					fmt.Printf("%v. %v\n", i, getReleaseString(ctx, location.GetFoundLocation().GetReleasesLocation()[i], false, false))
-					fmt.Printf("%v. %v\n", i+1, getReleaseString(ctx, location.GetFoundLocation().GetReleasesLocation()[i+1], false, false))
-				}
+			fmt.Printf("> %v\n", getReleaseString(location.GetPlacement(), false, false))
+			for _, view := range location.GetAfter() {
+				fmt.Printf("  %v\n", getReleaseString(view, false, false))
+			}
+			switch {
+			case len(location.GetBefore()) > 0 && len(location.GetAfter()) > 0:
+				fmt.Printf("Between %v and %v\n", getReleaseString(location.GetBefore()[len(location.GetBefore())-1], false, true), getReleaseString(location.GetAfter()[0], false, true))
+			case len(location.GetBefore()) > 0:
+				fmt.Printf("At the end of the slot, after %v\n", getReleaseString(location.GetBefore()[len(location.GetBefore())-1], false, true))
+			case len(location.GetAfter()) > 0:
+				fmt.Printf("At the start of the slot, before %v\n", getReleaseString(location.GetAfter()[0], false, true))
 			}
 		}
 	}
 }
 
-func getReleaseString(ctx context.Context, loc *pb.ReleasePlacement, showSleeve, simple bool) string {
-	rec, err := getRecord(ctx, loc.InstanceId)
-	if err != nil {
-		return fmt.Sprintf("%v", err)
-	}
-	/*if rec.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_DIGITAL || rec.GetMetadata().GetFiledUnder() == pbrc.ReleaseMetadata_FILE_CD {
-		return ""
-	}*/
-
+func getReleaseString(view *pb.PlacementView, showSleeve, simple bool) string {
 	if simple {
-		return rec.GetRelease().GetTitle()
+		return view.GetPlacement().GetTitle()
 	}
 
 	sleeve := ""
 	if showSleeve {
-		sleeve = fmt.Sprintf("%v", rec.GetMetadata().GetSleeve())
+		sleeve = " " + view.GetSleeve()
 	}
-	return fmt.Sprintf("%v (%v) %v ", rec.GetRelease().GetId(), rec.GetMetadata().GetRecordWidth(), rec.GetMetadata().GetLastListenTime()) + loc.Title + " " + fmt.Sprintf("%v", rec.GetMetadata().GetFiledUnder()) + " [" + strconv.Itoa(int(loc.InstanceId)) + "] - " + fmt.Sprintf("%v", rec.GetMetadata().GetCategory()) + " {" + fmt.Sprintf("%v", loc.GetDeterminedWidth()) + "} + " + fmt.Sprintf("%v", rec.GetMetadata().GetLastMoveTime()) + " [" + fmt.Sprintf("%v", rec.GetRelease().GetLabels()) + "]" + sleeve + fmt.Sprintf(" score%v", rec.Metadata.GetSetRating())
+	return fmt.Sprintf("%v (%v) %v - %v %v [%v] - %v {%v} [%v %v] %v", view.GetReleaseId(), view.GetWidth(), view.GetArtist(), view.GetPlacement().GetTitle(), view.GetFiled(), view.GetPlacement().GetInstanceId(), view.GetCategory(), view.GetPlacement().GetDeterminedWidth(), view.GetLabel(), view.GetCatno(), view.GetFormat()) + sleeve
 }
 
-func getRecord(ctx context.Context, id int64) (*pbrc.Record, error) {
-	conn, err := utils.LFDialServer(ctx, "recordcollection")
+// getViews pages through the placement rows for the request
+func getViews(ctx context.Context, client pb.OrganiserServiceClient, req *pb.GetOrganisationRequest) ([]*pb.Location, []*pb.PlacementView) {
+	req.PlacementsOnly = true
+	req.PageSize = 200
 
-	if err != nil {
-		log.Fatalf("Unable to dial: %v", err)
-	}
-	defer conn.Close()
-
-	client := pbrc.NewRecordCollectionServiceClient(conn)
-	val, err := client.GetRecord(ctx, &pbrc.GetRecordRequest{InstanceId: id})
-	if err != nil {
-		return nil, err
-	}
-	return val.GetRecord(), err
-}
-
-func isTwelve(ctx context.Context, instanceID int64) bool {
-	conn, err := utils.LFDialServer(ctx, "recordcollection")
-	defer conn.Close()
-
-	if err != nil {
-		log.Fatalf("Unable to dial: %v", err)
-	}
-
-	client := pbrc.NewRecordCollectionServiceClient(conn)
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
-	defer cancel()
-	rel, err := client.GetRecord(ctx, &pbrc.GetRecordRequest{InstanceId: instanceID})
-	if err != nil {
-		log.Fatalf("unable to get record (%v): %v", instanceID, err)
-	}
-
-	if rel.GetRecord().GetMetadata().GetGoalFolder() == 1782105 {
-		return false
-	}
+	var locations []*pb.Location
+	var views []*pb.PlacementView
+	for {
+		locs, err := client.GetOrganisation(ctx, req)
+		if err != nil {
+			log.Fatalf("Error reading locations: %v", err)
+		}
+		if locations == nil {
+			locations = locs.GetLocations()
+		}
+		views = append(views, locs.GetPlacements()...)
 
-	for _, f := range rel.GetRecord().GetRelease().Formats {
-		if f.Name == "LP" || f.Name == "Vinyl" {
-			return true
+		if locs.GetNextPageToken() == "" {
+			return locations, views
 		}
+		// Only reorg on the first page
+		req.ForceReorg = false
+		req.OrgReset = false
+		req.PageToken = locs.GetNextPageToken()
 	}
-
-	return false
 }
 
-func isSeven(ctx context.Context, instanceID int64) bool {
-	conn, err := utils.LFDialServer(ctx, "recordcollection")
-	defer conn.Close()
+// Records heading for this folder are left out of the twelves and sevens listings
+const skipGoalFolder = 1782105
 
+// goalFolder asks recordcollection where the record is meant to end up
+func goalFolder(ctx context.Context, instanceID int64) int32 {
+	conn, err := utils.LFDialServer(ctx, "recordcollection")
 	if err != nil {
 		log.Fatalf("Unable to dial: %v", err)
 	}
+	defer conn.Close()
 
 	client := pbrc.NewRecordCollectionServiceClient(conn)
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -182,71 +144,57 @@ func isSeven(ctx context.Context, instanceID int64) bool {
 	if err != nil {
 		log.Fatalf("unable to get record (%v): %v", instanceID, err)
 	}
+	return rel.GetRecord().GetMetadata().GetGoalFolder()
+}
 
-	if rel.GetRecord().GetMetadata().GetGoalFolder() == 1782105 {
-		return false
-	}
-
-	for _, f := range rel.GetRecord().GetRelease().Formats {
-		for _, d := range f.GetDescriptions() {
-			if d == "7\"" {
-				return true
+func get(ctx context.Context, client pb.OrganiserServiceClient, req *pb.GetOrganisationRequest, showSleeve bool, simple bool, skipGoal int32) {
+	locations, views := getViews(ctx, client, req)
+	if skipGoal > 0 {
+		var kept []*pb.PlacementView
+		for _, view := range views {
+			if goalFolder(ctx, view.GetPlacement().GetInstanceId()) != skipGoal {
+				kept = append(kept, view)
 			}
 		}
+		views = kept
 	}
 
-	return false
-}
-
-func get(ctx context.Context, client pb.OrganiserServiceClient, name string, force bool, slot int32, twelves bool, reset bool, sevens, showSleeve bool, justTen bool, simple bool) {
-	locs, err := client.GetOrganisation(ctx, &pb.GetOrganisationRequest{OrgReset: reset, ForceReorg: force, Locations: []*pb.Location{&pb.Location{Name: name}}})
-	if err != nil {
-		log.Fatalf("Error reading locations: %v", err)
+	counts := make(map[string]int)
+	for _, view := range views {
+		counts[view.GetLocation()]++
 	}
 
-	lastSlot := int32(1)
-	total := float32(0)
-	twidth := float32(0)
-	tcount := float32(0)
-	var widths []float64
-	for _, loc := range locs.GetLocations() {
-		fmt.Printf("%v (%v) -> %v [%v] with %v (%v) %v [Last reorg: %v from %v] (%v)\n", loc.GetName(), len(loc.GetReleasesLocation()), loc.GetFolderIds(), loc.GetQuota(), loc.Sort.String(), loc.GetSlots(), loc.GetSpillFolder(), time.Unix(loc.LastReorg, 0), loc.ReorgTime, loc.InPlay)
+	twidth := float64(0)
+	for _, loc := range locations {
+		fmt.Printf("%v (%v) -> %v [%v] with %v (%v) %v [Last reorg: %v from %v] (%v)\n", loc.GetName(), counts[loc.GetName()], loc.GetFolderIds(), loc.GetQuota(), loc.Sort.String(), loc.GetSlots(), loc.GetSpillFolder(), time.Unix(loc.LastReorg, 0), loc.ReorgTime, loc.InPlay)
 		fmt.Printf("%v\n", loc.GetFolderOrder())
 		fmt.Printf("%v\n", loc.GetFolderSort())
 		fmt.Printf("%v\n", loc.GetHardGap())
 		fmt.Printf("%v\n", loc.GetQuota())
 
-		for j, rloc := range loc.GetReleasesLocation() {
-			if slot < 0 || rloc.GetSlot() == slot {
-				if (!twelves || isTwelve(ctx, rloc.GetInstanceId())) && (!sevens || isSeven(ctx, rloc.GetInstanceId())) {
-					if rloc.GetSlot() > lastSlot {
-						fmt.Printf("\n")
-						lastSlot = rloc.GetSlot()
-					}
-					rec, err := getRecord(ctx, rloc.InstanceId)
-					if err != nil {
-						log.Fatalf("ARFH: %v", err)
-					}
-
-					if !justTen || isTenInch(rec.GetRelease()) {
-
-						fmt.Printf("%v [%v] %v %v [%v] \n", j, rloc.GetSlot(), rloc.GetInstanceId(), getReleaseString(ctx, rloc, showSleeve, simple), total)
-						total += rloc.GetDeterminedWidth()
-						if rec.GetMetadata().GetRecordWidth() > 0 {
-							twidth += rec.GetMetadata().GetRecordWidth()
-							tcount++
-							widths = append(widths, float64(rec.Metadata.GetRecordWidth()))
-						}
-					}
-				}
+		lastSlot := int32(-1)
+		total := float32(0)
+		j := 0
+		for _, view := range views {
+			if view.GetLocation() != loc.GetName() {
+				continue
+			}
+			rloc := view.GetPlacement()
+			if lastSlot >= 0 && rloc.GetSlot() > lastSlot {
+				fmt.Printf("\n")
 			}
+			lastSlot = rloc.GetSlot()
+
+			fmt.Printf("%v [%v] %v %v [%v] \n", j, rloc.GetSlot(), rloc.GetInstanceId(), getReleaseString(view, showSleeve, simple), total)
+			total += rloc.GetDeterminedWidth()
+			twidth += view.GetWidth()
+			j++
 		}
 	}
-	sort.Float64s(widths)
 
 	fmt.Printf("Total Width: %v\n", twidth)
 
-	if len(locs.GetLocations()) == 0 {
+	if len(locations) == 0 {
 		fmt.Printf("No Locations Found!\n")
 	}
 }
@@ -360,13 +308,42 @@ func main() {
 		var slot = getLocationFlags.Int("slot", 1, "Slot to view")
 		var twelves = getLocationFlags.Bool("twelves", false, "Just 12 inches")
 		var sevens = getLocationFlags.Bool("sevens", false, "Just 7 inches")
+		var size = getLocationFlags.String("size", "", "Just this size class (e.g. 7, 10, 12, CD)")
 		var reorg = getLocationFlags.Bool("reorg", false, "Do a full reorg")
 		var showSleeve = getLocationFlags.Bool("sleeves", false, "Show sleeve state")
 		var justTens = getLocationFlags.Bool("just_tens", false, "Just ten inches")
 		var simple = getLocationFlags.Bool("simple", false, "Simple display")
+		var folder = getLocationFlags.Int("folder", 0, "Just this folder")
+		var category = getLocationFlags.String("category", "", "Just this category")
+		var title = getLocationFlags.String("title", "", "Just titles containing this")
 
 		if err := getLocationFlags.Parse(os.Args[2:]); err == nil {
-			get(ctx, client, *name, *force, int32(*slot), *twelves, *reorg, *sevens, *showSleeve, *justTens, *simple)
+			switch {
+			case *twelves:
+				*size = "12"
+			case *sevens:
+				*size = "7"
+			case *justTens:
+				*size = "10"
+			}
+			req := &pb.GetOrganisationRequest{
+				OrgReset:   *reorg,
+				ForceReorg: *force,
+				Locations:  []*pb.Location{&pb.Location{Name: *name}},
+				SizeClass:  *size,
+				Folder:     int32(*folder),
+				Category:   *category,
+				Title:      *title,
+			}
+			if *slot >= 0 {
+				req.MinSlot = int32(*slot)
+				req.MaxSlot = int32(*slot)
+			}
+			skipGoal := int32(0)
+			if *twelves || *sevens {
+				skipGoal = skipGoalFolder
+			}
+			get(ctx, client, req, *showSleeve, *simple, skipGoal)
 		}
 	case "add":
 		addLocationFlags := flag.NewFlagSet("AddLocation", flag.ExitOnError)
@@ -388,8 +365,17 @@ func main() {
 	case "locate":
 		locateFlags := flag.NewFlagSet("Locate", flag.ExitOnError)
 		var id = locateFlags.Int("id", -1, "The id of the release")
+		var neighbours = locateFlags.Int("neighbours", 1, "The number of neighbours to show on each side")
+		var label = locateFlags.String("label", "", "The label to search on")
+		var catno = locateFlags.String("catno", "", "The catalogue number to search on")
+		var query = locateFlags.String("query", "", "Artist or title to search on")
+		var limit = locateFlags.Int("limit", 5, "The maximum number of matches to show")
 		if err := locateFlags.Parse(os.Args[2:]); err == nil {
-			locateRelease(ctx, client, int32(*id))
+			req := &pb.SearchRequest{Label: *label, Catno: *catno, Query: *query, Limit: int32(*limit)}
+			if *id > 0 {
+				req.ReleaseId = int32(*id)
+			}
+			locateRelease(ctx, client, req, int32(*neighbours))
 		}
 	case "quota":
 		quotaFlags := flag.NewFlagSet("quota", flag.ExitOnError)
@@ -517,6 +503,49 @@ func main() {
 				}
 			}
 		}
+	case "room":
+		roomFlags := flag.NewFlagSet("Room", flag.ExitOnError)
+		var name = roomFlags.String("name", "", "The name of the room")
+		var units = roomFlags.String("units", "", "The units in the room as name:rowsxcolumns:widthxheight,...")
+		var delete = roomFlags.Bool("delete", false, "Remove the room")
+
+		if err := roomFlags.Parse(os.Args[2:]); err == nil {
+			room := &pb.Room{Name: *name}
+			for _, elem := range strings.Split(*units, ",") {
+				if len(elem) == 0 {
+					continue
+				}
+				parts := strings.Split(elem, ":")
+				if len(parts) != 3 {
+					log.Fatalf("Bad unit %v, expected name:rowsxcolumns:widthxheight", elem)
+				}
+				var rows, columns int
+				var width, height float32
+				if _, err := fmt.Sscanf(parts[1], "%dx%d", &rows, &columns); err != nil {
+					log.Fatalf("Bad grid %v: %v", parts[1], err)
+				}
+				if _, err := fmt.Sscanf(parts[2], "%fx%f", &width, &height); err != nil {
+					log.Fatalf("Bad dimensions %v: %v", parts[2], err)
+				}
+				unit := &pb.Unit{Name: parts[0]}
+				for row := 1; row <= rows; row++ {
+					shelf := &pb.Shelf{Row: int32(row)}
+					for column := 1; column <= columns; column++ {
+						shelf.Slots = append(shelf.Slots, &pb.PhysicalSlot{Column: int32(column), Width: width, Height: height})
+					}
+					unit.Shelves = append(unit.Shelves, shelf)
+				}
+				room.Units = append(room.Units, unit)
+			}
+
+			res, err := client.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: room, Delete: *delete})
+			if err != nil {
+				log.Fatalf("Unable to update room: %v", err)
+			}
+			for _, r := range res.GetRooms() {
+				fmt.Printf("%v: %v units\n", r.GetName(), len(r.GetUnits()))
+			}
+		}
 	case "update":
 		updateLocationFlags := flag.NewFlagSet("UpdateLocation", flag.ExitOnError)
 		var name = updateLocationFlags.String("name", "", "The name of the new location")
@@ -539,6 +568,12 @@ func main() {
 		var adjust = updateLocationFlags.Bool("adjust", false, "Do adjust")
 		var absWidth = updateLocationFlags.Float64("abs_width", -1, "Overall width")
 		var absSlots = updateLocationFlags.Int("abs_slots", -1, "Slots")
+		var slotWidths = updateLocationFlags.String("slot_widths", "", "Per slot definitions as width[:height[:label]],...")
+		var slotPaths = updateLocationFlags.String("slot_paths", "", "Physical position of each slot as room/unit/row/column,...")
+		var sizeRoutes = updateLocationFlags.String("size_routes", "", "Shelve size classes apart as class:start_slot:sub_location,...")
+		var clearFields = updateLocationFlags.String("clear", "", "Comma separated location fields to clear, e.g. no_alert,spill_folder")
+		var removeFolder = updateLocationFlags.Int("remove_folder", 0, "The folder to remove from the location")
+		var removeGap = updateLocationFlags.Int("remove_gap", 0, "The folder to remove the hard gap from")
 
 		if err := updateLocationFlags.Parse(os.Args[2:]); err == nil {
 			if *absSlots > 0 {
@@ -554,6 +589,91 @@ func main() {
 					Update:   &pb.Location{Quota: &pb.Quota{QuotaType: &pb.Quota_AbsoluteWidth{float32(*absWidth)}}},
 				})
 			}
+			if len(*slotWidths) > 0 || len(*slotPaths) > 0 {
+				var defs []*pb.SlotDefinition
+				for _, elem := range strings.Split(*slotWidths, ",") {
+					if len(elem) == 0 {
+						continue
+					}
+					parts := strings.Split(elem, ":")
+					width, err := strconv.ParseFloat(parts[0], 32)
+					if err != nil {
+						log.Fatalf("Bad slot width %v: %v", parts[0], err)
+					}
+					def := &pb.SlotDefinition{Width: float32(width)}
+					if len(parts) > 1 {
+						def.HeightClass = parts[1]
+					}
+					if len(parts) > 2 {
+						def.Label = parts[2]
+					}
+					defs = append(defs, def)
+				}
+				// Paths line up with the widths by position, so every slot needs one
+				if len(*slotPaths) > 0 {
+					for i, elem := range strings.Split(*slotPaths, ",") {
+						if len(elem) == 0 {
+							log.Fatalf("Missing slot path for slot %v", i+1)
+						}
+						parts := strings.Split(elem, "/")
+						if len(parts) != 4 {
+							log.Fatalf("Bad slot path %v, expected room/unit/row/column", elem)
+						}
+						row, err := strconv.Atoi(parts[2])
+						if err != nil {
+							log.Fatalf("Bad row in %v: %v", elem, err)
+						}
+						column, err := strconv.Atoi(parts[3])
+						if err != nil {
+							log.Fatalf("Bad column in %v: %v", elem, err)
+						}
+						for len(defs) <= i {
+							defs = append(defs, &pb.SlotDefinition{})
+						}
+						defs[i].Physical = &pb.PhysicalPath{Room: parts[0], Unit: parts[1], Row: int32(row), Column: int32(column)}
+					}
+				}
+				_, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{SlotDefinitions: defs}})
+				if err != nil {
+					log.Fatalf("Unable to update slots: %v", err)
+				}
+			}
+			if len(*sizeRoutes) > 0 {
+				var routes []*pb.SizeRoute
+				for _, elem := range strings.Split(*sizeRoutes, ",") {
+					parts := strings.Split(elem, ":")
+					if len(parts) != 3 {
+						log.Fatalf("Bad size route %v, expected class:start_slot:sub_location", elem)
+					}
+					start, err := strconv.Atoi(parts[1])
+					if err != nil {
+						log.Fatalf("Bad start slot in %v: %v", elem, err)
+					}
+					routes = append(routes, &pb.SizeRoute{SizeClass: parts[0], StartSlot: int32(start), SubLocation: parts[2]})
+				}
+				_, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{SizeRoutes: routes}})
+				if err != nil {
+					log.Fatalf("Unable to update size routes: %v", err)
+				}
+			}
+			if len(*clearFields) > 0 {
+				_, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, UpdateMask: &fieldmaskpb.FieldMask{Paths: strings.Split(*clearFields, ",")}})
+				if err != nil {
+					log.Fatalf("Unable to clear %v: %v", *clearFields, err)
+				}
+			}
+			if *removeFolder > 0 {
+				_, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, RemoveFolders: []int32{int32(*removeFolder)}})
+				if err != nil {
+					log.Fatalf("Unable to remove folder: %v", err)
+				}
+			}
+			if *removeGap > 0 {
+				_, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, RemoveHardGaps: []int32{int32(*removeGap)}})
+				if err != nil {
+					log.Fatalf("Unable to remove gap: %v", err)
+				}
+			}
 			if *needStock {
 				client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{Checking: pb.Location_REQUIRE_STOCK_CHECK}})
 			}
@@ -564,34 +684,32 @@ func main() {
 				client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, DeleteLocation: true})
 			}
 			if *gap > 0 {
-				client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{
-					HardGap: map[int32]bool{int32(*gap): true},
-				}})
+				client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, AddHardGaps: []int32{int32(*gap)}})
 			}
 			if *folder > 0 && len(*sort) > 0 && *order >= 0 {
 				if *sort == "label" {
 					client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{
 						FolderOrder: map[int32]int32{int32(*folder): int32(*order)},
 						FolderSort:  map[int32]pb.Location_Sorting{int32(*folder): pb.Location_BY_LABEL_CATNO},
-						FolderIds:   []int32{int32(*folder)}}})
+					}, AddFolders: []int32{int32(*folder)}})
 				}
 				if *sort == "release" {
 					client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{
 						FolderOrder: map[int32]int32{int32(*folder): int32(*order)},
 						FolderSort:  map[int32]pb.Location_Sorting{int32(*folder): pb.Location_BY_RELEASE_DATE},
-						FolderIds:   []int32{int32(*folder)}}})
+					}, AddFolders: []int32{int32(*folder)}})
 				}
 				if *sort == "time" {
 					client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{
 						FolderOrder: map[int32]int32{int32(*folder): int32(*order)},
 						FolderSort:  map[int32]pb.Location_Sorting{int32(*folder): pb.Location_BY_DATE_ADDED},
-						FolderIds:   []int32{int32(*folder)}}})
+					}, AddFolders: []int32{int32(*folder)}})
 				}
 				if *sort == "listen" {
 					ur, err := client.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: *name, Update: &pb.Location{
 						FolderOrder: map[int32]int32{int32(*folder): int32(*order)},
 						FolderSort:  map[int32]pb.Location_Sorting{int32(*folder): pb.Location_BY_LAST_LISTEN},
-						FolderIds:   []int32{int32(*folder)}}})
+					}, AddFolders: []int32{int32(*folder)}})
 					fmt.Printf("%v, %v\n", ur, err)
 				}
 			}
@@ -645,8 +763,370 @@ func main() {
 		extractFlags := flag.NewFlagSet("Extract", flag.ExitOnError)
 		var label = extractFlags.Int("id", -1, "The ID of the label")
 		var reg = extractFlags.String("extract", "", "The extractor")
+		var test = extractFlags.Bool("test", false, "Show how the extractor would sort the label without saving it")
+		var remove = extractFlags.Bool("remove", false, "Remove the extractor for the label")
 		if err := extractFlags.Parse(os.Args[2:]); err == nil {
-			client.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: &pb.LabelExtractor{LabelId: int32(*label), Extractor: *reg}})
+			ex := &pb.LabelExtractor{LabelId: int32(*label), Extractor: *reg}
+			switch {
+			case *test:
+				res, err := client.TestExtractor(ctx, &pb.TestExtractorRequest{Extractor: ex})
+				if err != nil {
+					log.Fatalf("Unable to test extractor: %v", err)
+				}
+				for _, catno := range res.GetSorted() {
+					fmt.Printf("%v %v %v\n", catno.GetCatno(), catno.GetParts(), catno.GetMatched())
+				}
+				fmt.Printf("%v of %v did not match\n", res.GetUnmatched(), len(res.GetSorted()))
+			case *remove:
+				_, err := client.RemoveExtractor(ctx, &pb.RemoveExtractorRequest{LabelId: int32(*label)})
+				if err != nil {
+					log.Fatalf("Unable to remove extractor: %v", err)
+				}
+			default:
+				_, err := client.AddExtractor(ctx, &pb.AddExtractorRequest{Extractor: ex})
+				if err != nil {
+					log.Fatalf("Unable to add extractor: %v", err)
+				}
+			}
+		}
+	case "alias":
+		aliasFlags := flag.NewFlagSet("alias", flag.ExitOnError)
+		var label = aliasFlags.Int("id", 0, "The ID of the label")
+		var name = aliasFlags.String("name", "", "The canonical name for the label")
+		var family = aliasFlags.String("family", "", "The family the label is shelved with")
+		var remove = aliasFlags.Bool("delete", false, "Remove the alias")
+		if err := aliasFlags.Parse(os.Args[2:]); err == nil {
+			res, err := client.UpdateLabelAlias(ctx, &pb.UpdateLabelAliasRequest{Alias: &pb.LabelAlias{LabelId: int32(*label), CanonicalName: *name, Family: *family}, Delete: *remove})
+			if err != nil {
+				log.Fatalf("Unable to update alias: %v", err)
+			}
+			for _, alias := range res.GetAliases() {
+				fmt.Printf("%v: %v (%v)\n", alias.GetLabelId(), alias.GetCanonicalName(), alias.GetFamily())
+			}
+		}
+	case "override":
+		overrideFlags := flag.NewFlagSet("override", flag.ExitOnError)
+		var id = overrideFlags.Int("id", 0, "The instance ID of the record")
+		var label = overrideFlags.Int("label", 0, "The ID of the label to file under")
+		var catno = overrideFlags.String("catno", "", "The catalogue number to file under")
+		var remove = overrideFlags.Bool("delete", false, "Remove the override")
+		if err := overrideFlags.Parse(os.Args[2:]); err == nil {
+			res, err := client.SetLabelOverride(ctx, &pb.SetLabelOverrideRequest{Override: &pb.LabelOverride{InstanceId: int64(*id), LabelId: int32(*label), Catno: *catno}, Delete: *remove})
+			if err != nil {
+				log.Fatalf("Unable to set override: %v", err)
+			}
+			for _, override := range res.GetOverrides() {
+				fmt.Printf("%v: %v %v\n", override.GetInstanceId(), override.GetLabelId(), override.GetCatno())
+			}
+		}
+	case "extractors":
+		res, err := client.ListExtractors(ctx, &pb.ListExtractorsRequest{})
+		if err != nil {
+			log.Fatalf("Unable to list extractors: %v", err)
+		}
+		for _, ex := range res.GetExtractors() {
+			fmt.Printf("%v: %v\n", ex.GetLabelId(), ex.GetExtractor())
+		}
+	case "slot":
+		slotFlags := flag.NewFlagSet("slot", flag.ExitOnError)
+		var name = slotFlags.String("name", "", "The name of the location")
+		var slot = slotFlags.Int("slot", 1, "The slot to show")
+		var label = slotFlags.Bool("label", false, "Just print the label for the slot")
+		if err := slotFlags.Parse(os.Args[2:]); err == nil {
+			res, err := client.GetSlot(ctx, &pb.GetSlotRequest{Location: *name, Slot: int32(*slot)})
+			if err != nil {
+				log.Fatalf("Unable to get slot: %v", err)
+			}
+
+			sheet := fmt.Sprintf("%v %v", *name, *slot)
+			if len(res.GetContents()) > 0 {
+				sheet = fmt.Sprintf("%v %v – %v %v", res.GetFirst().GetLabel(), res.GetFirst().GetCatno(), res.GetLast().GetLabel(), res.GetLast().GetCatno())
+			}
+			if *label {
+				fmt.Printf("%v\n", sheet)
+			} else {
+				fmt.Printf("%v [%.1f / %.1f]\n", sheet, res.GetTotalWidth(), res.GetCapacity())
+				for _, view := range res.GetContents() {
+					fmt.Printf("%v. %v\n", view.GetPlacement().GetIndex()+1, getReleaseString(view, false, false))
+				}
+			}
+		}
+	case "apply":
+		applyFlags := flag.NewFlagSet("apply", flag.ExitOnError)
+		var file = applyFlags.String("file", "", "The textproto config to apply")
+		var dryRun = applyFlags.Bool("dry_run", true, "Only show the changes")
+		var prune = applyFlags.Bool("prune", false, "Remove locations, extractors, aliases and overrides not in the config")
+		if err := applyFlags.Parse(os.Args[2:]); err == nil {
+			data, err := os.ReadFile(*file)
+			if err != nil {
+				log.Fatalf("Unable to read %v: %v", *file, err)
+			}
+			config := &pb.OrganisationConfig{}
+			if err := prototext.Unmarshal(data, config); err != nil {
+				log.Fatalf("Unable to parse %v: %v", *file, err)
+			}
+
+			res, err := client.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: config, DryRun: *dryRun, Prune: *prune})
+			if err != nil {
+				log.Fatalf("Unable to apply config: %v", err)
+			}
+			for _, line := range res.GetDiff() {
+				fmt.Printf("%v\n", line)
+			}
+			if len(res.GetDiff()) == 0 {
+				fmt.Printf("No changes\n")
+			} else if !res.GetApplied() {
+				fmt.Printf("Dry run, rerun with -dry_run=false to apply\n")
+			}
+		}
+	case "validate":
+		res, err := client.ValidateOrganisation(ctx, &pb.ValidateOrganisationRequest{})
+		if err != nil {
+			log.Fatalf("Unable to validate: %v", err)
+		}
+		for _, v := range res.GetViolations() {
+			fmt.Printf("%v: %v\n", v.GetField(), v.GetDescription())
+		}
+		if len(res.GetViolations()) == 0 {
+			fmt.Printf("No problems found\n")
+		}
+	case "coverage":
+		res, err := client.Coverage(ctx, &pb.CoverageRequest{})
+		if err != nil {
+			log.Fatalf("Unable to get coverage: %v", err)
+		}
+		for _, overlap := range res.GetOverlaps() {
+			fmt.Printf("Folder %v is claimed by %v\n", overlap.GetFolderId(), strings.Join(overlap.GetLocations(), ", "))
+		}
+		for _, unowned := range res.GetUnowned() {
+			fmt.Printf("Folder %v is unowned and holds %v records\n", unowned.GetFolderId(), len(unowned.GetInstanceIds()))
+		}
+		fmt.Printf("%v records are not on a shelf\n", len(res.GetUnplaced()))
+		if len(res.GetUnchecked()) > 0 {
+			fmt.Printf("%v records were not checked\n", len(res.GetUnchecked()))
+		}
+	case "rename":
+		renameFlags := flag.NewFlagSet("rename", flag.ExitOnError)
+		var name = renameFlags.String("name", "", "The location to rename")
+		var newName = renameFlags.String("to", "", "The new name")
+		if err := renameFlags.Parse(os.Args[2:]); err == nil {
+			res, err := client.RenameLocation(ctx, &pb.RenameLocationRequest{Name: *name, NewName: *newName})
+			if err != nil {
+				log.Fatalf("Unable to rename: %v", err)
+			}
+			fmt.Printf("Renamed %v to %v (%v audit entries)\n", *name, res.GetLocation().GetName(), res.GetAuditEntries())
+			if res.GetAuditError() != "" {
+				fmt.Printf("Audit entries were left under %v: %v\n", *name, res.GetAuditError())
+			}
+		}
+	case "reorder":
+		reorderFlags := flag.NewFlagSet("reorder", flag.ExitOnError)
+		var names = reorderFlags.String("names", "", "Comma separated locations to list first")
+		if err := reorderFlags.Parse(os.Args[2:]); err == nil {
+			res, err := client.ReorderLocations(ctx, &pb.ReorderLocationsRequest{Names: strings.Split(*names, ",")})
+			if err != nil {
+				log.Fatalf("Unable to reorder: %v", err)
+			}
+			for i, name := range res.GetNames() {
+				fmt.Printf("%v. %v\n", i+1, name)
+			}
+		}
+	case "divergence":
+		divergenceFlags := flag.NewFlagSet("divergence", flag.ExitOnError)
+		var names = divergenceFlags.String("name", "", "Comma separated locations to check, all if empty")
+		if err := divergenceFlags.Parse(os.Args[2:]); err == nil {
+			req := &pb.SortDivergenceRequest{}
+			if len(*names) > 0 {
+				req.Locations = strings.Split(*names, ",")
+			}
+			res, err := client.SortDivergence(ctx, req)
+			if err != nil {
+				log.Fatalf("Unable to check sorting: %v", err)
+			}
+			for _, d := range res.GetDivergences() {
+				fmt.Printf("%v (folder %v) position %v: %v vs %v\n", d.GetLocation(), d.GetFolder(), d.GetPosition(), d.GetLiveInstanceId(), d.GetCachedInstanceId())
+			}
+			fmt.Printf("%v divergences in %v records\n", len(res.GetDivergences()), res.GetChecked())
+			if len(res.GetStale()) > 0 {
+				fmt.Printf("%v records have stale cache entries\n", len(res.GetStale()))
+			}
+		}
+	case "export":
+		exportFlags := flag.NewFlagSet("export", flag.ExitOnError)
+		var names = exportFlags.String("name", "", "Comma separated locations to export, all if empty")
+		var format = exportFlags.String("format", "csv", "Output format, csv, json or markdown")
+		var out = exportFlags.String("out", "", "The file to write to, stdout if empty")
+		if err := exportFlags.Parse(os.Args[2:]); err == nil {
+			val, ok := pb.ExportOrganisationRequest_Format_value[strings.ToUpper(*format)]
+			if !ok {
+				log.Fatalf("Unknown format %v", *format)
+			}
+			req := &pb.ExportOrganisationRequest{Format: pb.ExportOrganisationRequest_Format(val)}
+			if len(*names) > 0 {
+				req.Locations = strings.Split(*names, ",")
+			}
+
+			res, err := client.ExportOrganisation(ctx, req)
+			if err != nil {
+				log.Fatalf("Unable to export: %v", err)
+			}
+
+			if len(*out) > 0 {
+				err = os.WriteFile(*out, res.GetData(), 0644)
+			} else {
+				_, err = os.Stdout.Write(res.GetData())
+			}
+			if err != nil {
+				log.Fatalf("Unable to write export: %v", err)
+			}
+		}
+	case "labels":
+		labelFlags := flag.NewFlagSet("labels", flag.ExitOnError)
+		var name = labelFlags.String("name", "", "The name of the location")
+		var format = labelFlags.String("format", "pdf", "Output format, pdf or svg")
+		var out = labelFlags.String("out", "", "The file to write to, stdout if empty")
+		if err := labelFlags.Parse(os.Args[2:]); err == nil {
+			locations, views := getViews(ctx, client, &pb.GetOrganisationRequest{Locations: []*pb.Location{{Name: *name}}})
+			if len(locations) == 0 {
+				log.Fatalf("Unable to find %v", *name)
+			}
+
+			w := os.Stdout
+			if len(*out) > 0 {
+				f, err := os.Create(*out)
+				if err != nil {
+					log.Fatalf("Unable to create %v: %v", *out, err)
+				}
+				defer f.Close()
+				w = f
+			}
+
+			var cards []labels.Card
+			for _, loc := range locations {
+				var lviews []*pb.PlacementView
+				for _, view := range views {
+					if view.GetLocation() == loc.GetName() {
+						lviews = append(lviews, view)
+					}
+				}
+				cards = append(cards, labels.Cards(loc, lviews)...)
+			}
+			switch *format {
+			case "svg":
+				err = labels.RenderSVG(w, cards)
+			case "pdf":
+				err = labels.RenderPDF(w, cards)
+			default:
+				log.Fatalf("Unknown format %v", *format)
+			}
+			if err != nil {
+				log.Fatalf("Unable to render labels: %v", err)
+			}
+		}
+	case "picklist":
+		pickFlags := flag.NewFlagSet("picklist", flag.ExitOnError)
+		var file = pickFlags.String("file", "", "File of instance ids, reads stdin if empty")
+		if err := pickFlags.Parse(os.Args[2:]); err == nil {
+			in := os.Stdin
+			if len(*file) > 0 {
+				f, err := os.Open(*file)
+				if err != nil {
+					log.Fatalf("Unable to open %v: %v", *file, err)
+				}
+				defer f.Close()
+				in = f
+			}
+
+			var ids []int64
+			scanner := bufio.NewScanner(in)
+			scanner.Split(bufio.ScanWords)
+			for scanner.Scan() {
+				id, err := strconv.ParseInt(scanner.Text(), 10, 64)
+				if err != nil {
+					log.Fatalf("Bad instance id %v: %v", scanner.Text(), err)
+				}
+				ids = append(ids, id)
+			}
+			if err := scanner.Err(); err != nil {
+				log.Fatalf("Unable to read ids: %v", err)
+			}
+
+			res, err := client.BatchLocate(ctx, &pb.BatchLocateRequest{InstanceIds: ids})
+			if err != nil {
+				log.Fatalf("Unable to locate: %v", err)
+			}
+			for _, group := range res.GetGroups() {
+				if group.GetPath() != nil {
+					fmt.Printf("%v: %v, %v, row %v, column %v\n", group.GetLocation(), group.GetPath().GetRoom(), group.GetPath().GetUnit(), group.GetPath().GetRow(), group.GetPath().GetColumn())
+				} else {
+					fmt.Printf("%v: slot %v\n", group.GetLocation(), group.GetSlot())
+				}
+				for _, pick := range group.GetPicks() {
+					fmt.Printf("  %v. %v\n", pick.GetPlacement().GetIndex()+1, getReleaseString(pick, false, false))
+				}
+			}
+			if len(res.GetMissing()) > 0 {
+				fmt.Printf("Not found: %v\n", res.GetMissing())
+			}
+		}
+	case "audit":
+		auditFlags := flag.NewFlagSet("Audit", flag.ExitOnError)
+		var name = auditFlags.String("name", "", "The location to filter on")
+		var id = auditFlags.Int("id", 0, "The instance id to filter on")
+		var since = auditFlags.Duration("since", 0, "Only show entries within this duration")
+		if err := auditFlags.Parse(os.Args[2:]); err == nil {
+			req := &pb.QueryAuditRequest{Location: *name, InstanceId: int64(*id)}
+			if *since > 0 {
+				req.StartTime = time.Now().Add(-*since).Unix()
+			}
+			resp, err := client.QueryAudit(ctx, req)
+			if err != nil {
+				log.Fatalf("Unable to query audit: %v", err)
+			}
+			for _, entry := range resp.GetEntries() {
+				fmt.Printf("%v %v %v [%v] %v (%v)\n", time.Unix(entry.GetTimestamp(), 0), entry.GetInstanceId(), entry.GetAction(), entry.GetLocation(), entry.GetReason(), entry.GetQuotaState())
+			}
+		}
+	case "sleeve":
+		sleeveFlags := flag.NewFlagSet("Sleeve", flag.ExitOnError)
+		var sleeve = sleeveFlags.String("sleeve", "", "The sleeve state to adjust")
+		var factor = sleeveFlags.Float64("factor", 0, "The width multiplier, 0 resets to default")
+		if err := sleeveFlags.Parse(os.Args[2:]); err == nil {
+			val, ok := pbrc.ReleaseMetadata_SleeveState_value[*sleeve]
+			if !ok {
+				log.Fatalf("Unknown sleeve state: %v", *sleeve)
+			}
+			resp, err := client.SetSleeveFactor(ctx, &pb.SetSleeveFactorRequest{Sleeve: val, Factor: float32(*factor)})
+			if err != nil {
+				log.Fatalf("Unable to set sleeve factor: %v", err)
+			}
+			for key, val := range resp.GetSleeveFactors() {
+				fmt.Printf("%v -> %v\n", pbrc.ReleaseMetadata_SleeveState(key), val)
+			}
+		}
+	case "protect":
+		protectFlags := flag.NewFlagSet("Protect", flag.ExitOnError)
+		var pin = protectFlags.Int("pin", -1, "Instance id to protect from sale")
+		var unpin = protectFlags.Int("unpin", -1, "Instance id to remove protection from")
+		var days = protectFlags.Int("min_days", 0, "Minimum days owned before a record can be sold, -1 to clear")
+		if err := protectFlags.Parse(os.Args[2:]); err == nil {
+			req := &pb.UpdateProtectionRequest{}
+			if *pin > 0 {
+				req.Pin = append(req.Pin, int64(*pin))
+			}
+			if *unpin > 0 {
+				req.Unpin = append(req.Unpin, int64(*unpin))
+			}
+			if *days > 0 {
+				req.MinOwnershipSeconds = int64(*days) * 60 * 60 * 24
+			} else if *days < 0 {
+				req.MinOwnershipSeconds = -1
+			}
+			resp, err := client.UpdateProtection(ctx, req)
+			if err != nil {
+				log.Fatalf("Unable to update protection: %v", err)
+			}
+			fmt.Printf("Pinned: %v, min age: %v\n", resp.GetRules().GetPinnedIds(), time.Duration(resp.GetRules().GetMinOwnershipSeconds())*time.Second)
 		}
 	}
 }
@@ -28,7 +28,7 @@ var data = []struct {
 func TestMarkWithinQuota(t *testing.T) {
 	s := getTestServer(".makrWithinQuota")
 	c := &pb.Location{OverQuotaTime: time.Now().Unix()}
-	s.markOverQuota(context.Background(), c)
+	s.markOverQuota(context.Background(), c, &pb.ProtectionRules{}, nil)
 
 	if c.OverQuotaTime > 0 {
 		t.Errorf("Quota has not been nulled out: %v", c)
@@ -8,7 +8,6 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-
 	"google.golang.org/protobuf/proto"
 
 	pbgd "github.com/brotherlogic/godiscogs/proto"
@@ -22,18 +21,31 @@ func (s *Server) UpdateLocation(ctx context.Context, req *pb.UpdateLocationReque
 	if err != nil {
 		return nil, err
 	}
+	before := validateOrganisation(org)
 
+	deleted := ""
 	for i, loc := range org.GetLocations() {
 		if loc.GetName() == req.GetLocation() {
 			if req.DeleteLocation {
 				org.Locations = append(org.GetLocations()[:i], org.GetLocations()[i+1:]...)
-			} else {
-				proto.Merge(loc, req.Update)
+				deleted = loc.GetName()
+			} else if err := updateLocation(org, loc, req); err != nil {
+				return nil, err
 			}
 		}
 	}
 
-	return &pb.UpdateLocationResponse{}, s.saveOrg(ctx, org)
+	if err := checkOrganisation(before, org); err != nil {
+		return nil, err
+	}
+	if err := s.saveOrg(ctx, org); err != nil {
+		return nil, err
+	}
+
+	if deleted != "" {
+		deleteLocationMetrics(deleted)
+	}
+	return &pb.UpdateLocationResponse{}, nil
 }
 
 // Locate finds a record in the collection
@@ -43,20 +55,84 @@ func (s *Server) Locate(ctx context.Context, req *pb.LocateRequest) (*pb.LocateR
 		return nil, err
 	}
 
+	loc, rp := locate(org, req)
+	if loc == nil {
+		return &pb.LocateResponse{}, status.Errorf(codes.NotFound, "Unable to locate %v in collection", req.GetInstanceId())
+	}
+	if rp == nil {
+		return &pb.LocateResponse{FoundLocation: leanLocation(loc)}, nil
+	}
+
+	neighbours := int32(1)
+	if req.Neighbours != nil {
+		neighbours = req.GetNeighbours()
+	}
+	return locateResponse(loc, rp, s.displayCache(ctx), neighbours), nil
+}
+
+// locate finds the location holding the instance, or failing that the folder
+func locate(org *pb.Organisation, req *pb.LocateRequest) (*pb.Location, *pb.ReleasePlacement) {
 	for _, loc := range org.GetLocations() {
 		for _, r := range loc.GetReleasesLocation() {
 			if r.GetInstanceId() == req.GetInstanceId() {
-				return &pb.LocateResponse{FoundLocation: loc}, nil
+				return loc, r
 			}
 		}
-		for _, f := range loc.GetFolderIds() {
-			if f == req.GetFolderId() {
-				return &pb.LocateResponse{FoundLocation: loc}, nil
+	}
+	return locationForFolder(org, req.GetFolderId()), nil
+}
+
+// leanLocation copies the location without its placements
+func leanLocation(loc *pb.Location) *pb.Location {
+	lean := proto.Clone(loc).(*pb.Location)
+	lean.ReleasesLocation = nil
+	return lean
+}
+
+func locateResponse(loc *pb.Location, rp *pb.ReleasePlacement, cache *pb.SortingCache, neighbours int32) *pb.LocateResponse {
+	if neighbours < 0 {
+		neighbours = 0
+	}
+
+	resp := &pb.LocateResponse{
+		FoundLocation: leanLocation(loc),
+		Path:          physicalPath(loc, rp.GetSlot()),
+		Position:      rp.GetIndex() + 1,
+	}
+
+	entries := cacheIndex(cache)
+	var slot []*pb.PlacementView
+	pos := 0
+	for _, p := range loc.GetReleasesLocation() {
+		if p.GetSlot() == rp.GetSlot() {
+			if p.GetInstanceId() == rp.GetInstanceId() {
+				pos = len(slot)
 			}
+			slot = append(slot, placementView(loc, p, entries[p.GetInstanceId()]))
 		}
 	}
 
-	return &pb.LocateResponse{}, status.Errorf(codes.NotFound, "Unable to locate %v in collection", req.GetInstanceId())
+	for i, view := range slot {
+		if i < pos {
+			resp.DistanceFromStart += view.GetPlacement().GetDeterminedWidth()
+		} else if i > pos {
+			resp.DistanceFromEnd += view.GetPlacement().GetDeterminedWidth()
+		}
+	}
+
+	start := pos - int(neighbours)
+	if start < 0 {
+		start = 0
+	}
+	end := pos + 1 + int(neighbours)
+	if end > len(slot) {
+		end = len(slot)
+	}
+
+	resp.Placement = slot[pos]
+	resp.Before = slot[start:pos]
+	resp.After = slot[pos+1 : end]
+	return resp
 }
 
 // AddLocation adds a location
@@ -66,12 +142,17 @@ func (s *Server) AddLocation(ctx context.Context, req *pb.AddLocationRequest) (*
 		return nil, err
 	}
 
+	before := validateOrganisation(org)
+	org.Locations = append(org.Locations, req.GetAdd())
+	if err := checkOrganisation(before, org); err != nil {
+		return nil, err
+	}
+
 	cache, err := s.loadCache(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	org.Locations = append(org.Locations, req.GetAdd())
 	err = s.saveOrg(ctx, org)
 	if err != nil {
 		return nil, err
@@ -166,7 +247,24 @@ func (s *Server) GetOrganisation(ctx context.Context, req *pb.GetOrganisationReq
 		return nil, status.Errorf(codes.NotFound, "Could not find locations: %v", req.GetLocations())
 	}
 
-	return &pb.GetOrganisationResponse{Locations: locations, NumberProcessed: num}, nil
+	if !wantsViews(req) {
+		return &pb.GetOrganisationResponse{Locations: locations, NumberProcessed: num}, nil
+	}
+
+	views, next, err := buildViews(req, locations, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	// The rows carry the placements, so the locations are sent without them and only once
+	var lean []*pb.Location
+	if req.GetPageToken() == "" {
+		for _, loc := range locations {
+			lean = append(lean, leanLocation(loc))
+		}
+	}
+
+	return &pb.GetOrganisationResponse{Locations: lean, NumberProcessed: num, Placements: views, NextPageToken: next}, nil
 }
 
 // GetQuota fills out the quota response
@@ -218,19 +316,17 @@ func (s *Server) GetQuota(ctx context.Context, req *pb.QuotaRequest) (*pb.QuotaR
 
 		// New Style quota part 2
 		if loc.GetQuota().GetWidth() > 0 {
-			totalWidth := float32(0)
-			for _, r := range recs {
-				if r.GetMetadata().RecordWidth <= 0 {
-					s.RaiseIssue("Missing Spine Width", fmt.Sprintf("Record %v is missing spine width (%v)", r.GetRelease().Title, r.GetRelease().Id))
-					return nil, fmt.Errorf("Unable to compute quota - missing width")
-				}
-				totalWidth += r.GetMetadata().RecordWidth
+			cache, err := s.loadCache(ctx)
+			if err != nil {
+				return nil, err
 			}
+
+			totalWidth, estimated, confidence := estimateTotalWidth(newWidthEstimator(cache, org.GetSleeveFactors(), newLabelRules(org)), recs)
 			if totalWidth > loc.GetQuota().GetWidth() {
 				s.RaiseIssue("Quota Problem", fmt.Sprintf("%v is over quota", loc.GetName()))
 			}
 
-			return &pb.QuotaResponse{OverQuota: totalWidth > loc.GetQuota().GetWidth(), LocationName: loc.GetName(), InstanceId: instanceIDs, Quota: loc.GetQuota()}, nil
+			return &pb.QuotaResponse{OverQuota: totalWidth > loc.GetQuota().GetWidth(), LocationName: loc.GetName(), InstanceId: instanceIDs, Quota: loc.GetQuota(), Estimated: estimated, Confidence: confidence}, nil
 		}
 	}
 
@@ -244,7 +340,19 @@ func (s *Server) AddExtractor(ctx context.Context, req *pb.AddExtractorRequest)
 		return nil, err
 	}
 
-	org.Extractors = append(org.Extractors, req.GetExtractor())
+	before := validateOrganisation(org)
+
+	// A new extractor replaces any existing one for the label
+	var extractors []*pb.LabelExtractor
+	for _, ex := range org.GetExtractors() {
+		if ex.GetLabelId() != req.GetExtractor().GetLabelId() {
+			extractors = append(extractors, ex)
+		}
+	}
+	org.Extractors = append(extractors, req.GetExtractor())
+	if err := checkOrganisation(before, org); err != nil {
+		return nil, err
+	}
 	return &pb.AddExtractorResponse{}, s.saveOrg(ctx, org)
 }
 
@@ -294,24 +402,21 @@ func (s *Server) ClientUpdate(ctx context.Context, req *rcpb.ClientUpdateRequest
 	}
 
 	oldLoc := &pb.Location{}
-	newLoc := &pb.Location{}
 	for _, loc := range org.GetLocations() {
 		for _, place := range loc.GetReleasesLocation() {
 			if place.GetInstanceId() == record.GetRelease().GetInstanceId() {
 				oldLoc = loc
 			}
 		}
-
-		for _, folder := range loc.GetFolderIds() {
-			if folder == record.GetRelease().GetFolderId() {
-				newLoc = loc
-			}
-		}
+	}
+	newLoc := locationForFolder(org, record.GetRelease().GetFolderId())
+	if newLoc == nil {
+		newLoc = &pb.Location{}
 	}
 
 	if oldLoc.GetName() != newLoc.GetName() {
 		// Update the cache
-		cache, err := s.updateCache(ctx, record)
+		cache, err := s.updateCache(ctx, record, compileExtractors(convert(org.GetExtractors())), newLabelRules(org))
 		if err != nil {
 			return nil, err
 		}
@@ -334,7 +439,7 @@ func (s *Server) ClientUpdate(ctx context.Context, req *rcpb.ClientUpdateRequest
 
 		if len(oldLoc.GetName()) > 0 || len(newLoc.GetName()) > 0 {
 			if record.GetMetadata().GetBoxState() != rcpb.ReleaseMetadata_IN_THE_BOX {
-				_, err := s.bridge.updateRecord(ctx, &rcpb.UpdateRecordRequest{Reason: fmt.Sprintf("Org Move Update (%v -> %v)", oldLoc.GetName(), newLoc.GetName()), Update: &rcpb.Record{Release: &pbgd.Release{InstanceId: record.GetRelease().GetInstanceId()}}})
+				_, err := s.updateRecord(ctx, newLoc, "move-update", &rcpb.UpdateRecordRequest{Reason: fmt.Sprintf("Org Move Update (%v -> %v)", oldLoc.GetName(), newLoc.GetName()), Update: &rcpb.Record{Release: &pbgd.Release{InstanceId: record.GetRelease().GetInstanceId()}}})
 				return &rcpb.ClientUpdateResponse{}, err
 			}
 		}
@@ -55,9 +55,8 @@ func (s *Server) getRecordsForFolder(ctx context.Context, sloc *pb.Location) []*
 	return recs
 }
 
-func (s *Server) processQuota(ctx context.Context, c *pb.Location) error {
+func (s *Server) processQuota(ctx context.Context, c *pb.Location, rules *pb.ProtectionRules) error {
 	slots := int(c.GetQuota().GetNumOfSlots())
-	existing := len(c.ReleasesLocation)
 
 	c.OverQuotaTime = 0
 
@@ -67,26 +66,19 @@ func (s *Server) processQuota(ctx context.Context, c *pb.Location) error {
 	maxGoroutines := 100
 	guard := make(chan struct{}, maxGoroutines)
 	var ferr error
+	lock := &sync.Mutex{}
 	for _, rp := range c.ReleasesLocation {
 		guard <- struct{}{}
 		wg.Add(1)
 		go func(iid int64) {
 			r, err := s.bridge.getRecord(ctx, iid)
+			lock.Lock()
 			if err != nil {
 				ferr = err
 			} else {
-				if !r.GetMetadata().GetNeedsGramUpdate() {
-					found := false
-					for _, folder := range c.GetFolderIds() {
-						if folder == r.GetRelease().GetFolderId() {
-							found = true
-						}
-					}
-					if found {
-						records = append(records, r)
-					}
-				}
+				records = append(records, r)
 			}
+			lock.Unlock()
 			wg.Done()
 			<-guard
 		}(rp.GetInstanceId())
@@ -99,18 +91,27 @@ func (s *Server) processQuota(ctx context.Context, c *pb.Location) error {
 
 	// Sort the record
 	sort.Sort(sales.BySaleOrder(records))
+	candidates, protected := sellCandidates(rules, c, records)
 
-	if len(records) > 0 {
-		for i := 0; i < existing-slots; i++ {
-			s.CtxLog(ctx, fmt.Sprintf("Attempting with %v", records[i]))
-			up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: records[i].GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
-			s.bridge.updateRecord(ctx, up)
-		}
+	// Records which have left the location no longer take up a slot
+	existing := len(candidates)
+	for _, count := range protected {
+		existing += count
+	}
+
+	for i := 0; i < existing-slots && i < len(candidates); i++ {
+		s.CtxLog(ctx, fmt.Sprintf("Attempting with %v", candidates[i]))
+		up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: candidates[i].GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
+		s.updateRecord(ctx, c, "prepare-to-sell", up)
+	}
+
+	if existing-slots > len(candidates) {
+		return s.nothingSellable(ctx, c, existing-slots-len(candidates), protected)
 	}
 	return nil
 }
 
-func (s *Server) processAbsoluteWidthQuota(ctx context.Context, c *pb.Location) error {
+func (s *Server) processAbsoluteWidthQuota(ctx context.Context, c *pb.Location, rules *pb.ProtectionRules) error {
 	twidth := float32(0)
 
 	gwidth.With(prometheus.Labels{"location": c.GetName()}).Set(float64(c.GetQuota().GetAbsoluteWidth()))
@@ -133,18 +134,15 @@ func (s *Server) processAbsoluteWidthQuota(ctx context.Context, c *pb.Location)
 		sort.Sort(sales.BySaleOrder(records))
 
 		// Find the first appropriate record
-		r := records[0]
-		for _, prec := range records {
-			if prec.GetMetadata().GetBoxState() == pbrc.ReleaseMetadata_BOX_UNKNOWN ||
-				prec.GetMetadata().GetBoxState() == pbrc.ReleaseMetadata_OUT_OF_BOX {
-				r = prec
-				break
-			}
+		candidates, protected := sellCandidates(rules, c, records)
+		if len(candidates) == 0 {
+			return s.nothingSellable(ctx, c, 1, protected)
 		}
+		r := candidates[0]
 		s.CtxLog(ctx, fmt.Sprintf("Attempting to force sell: %v", r.GetRelease().GetInstanceId()))
 
 		up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: r.GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
-		_, err := s.bridge.updateRecord(ctx, up)
+		_, err := s.updateRecord(ctx, c, "prepare-to-sell", up)
 		if err != nil {
 			return err
 		}
@@ -153,7 +151,7 @@ func (s *Server) processAbsoluteWidthQuota(ctx context.Context, c *pb.Location)
 	return nil
 }
 
-func (s *Server) processSlotQuota(ctx context.Context, c *pb.Location) error {
+func (s *Server) processSlotQuota(ctx context.Context, c *pb.Location, rules *pb.ProtectionRules) error {
 	mslot := int32(0)
 	cover := float64(0)
 	for _, elem := range c.GetReleasesLocation() {
@@ -180,6 +178,9 @@ func (s *Server) processSlotQuota(ctx context.Context, c *pb.Location) error {
 			records = append(records, rec)
 		}
 
+		if len(records) == 0 {
+			return nil
+		}
 		sort.Sort(sales.BySaleOrder(records))
 
 		// Validate scores
@@ -190,33 +191,20 @@ func (s *Server) processSlotQuota(ctx context.Context, c *pb.Location) error {
 				diff = true
 			}
 		}
+
+		// Find the first appropriate record, raising at most one issue for the slot
+		candidates, protected := sellCandidates(rules, c, records)
+		if len(candidates) == 0 {
+			return s.nothingSellable(ctx, c, 1, protected)
+		}
 		if !diff {
 			s.RaiseIssue("Slot Stocked", fmt.Sprintf("%v is stocked", c.GetName()))
 		}
-
-		// Find the first appropriate record
-		r := records[0]
-		for _, prec := range records {
-			if prec.GetMetadata().GetBoxState() == pbrc.ReleaseMetadata_BOX_UNKNOWN ||
-				prec.GetMetadata().GetBoxState() == pbrc.ReleaseMetadata_OUT_OF_BOX {
-				if !prec.GetMetadata().GetNeedsGramUpdate() {
-					found := false
-					for _, folder := range c.GetFolderIds() {
-						if folder == prec.GetRelease().GetFolderId() {
-							found = true
-						}
-					}
-					if found {
-						r = prec
-					}
-				}
-				break
-			}
-		}
+		r := candidates[0]
 		s.CtxLog(ctx, fmt.Sprintf("Attempting to sell (%v): %v -> %v", c.GetName(), r.GetRelease().GetInstanceId(), r))
 
 		up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: r.GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
-		_, err := s.bridge.updateRecord(ctx, up)
+		_, err := s.updateRecord(ctx, c, "prepare-to-sell", up)
 		if err != nil {
 			return err
 		}
@@ -225,8 +213,11 @@ func (s *Server) processSlotQuota(ctx context.Context, c *pb.Location) error {
 	return nil
 }
 
-func (s *Server) processWidthQuota(ctx context.Context, c *pb.Location) error {
-	for slot := 0; slot <= int(c.GetSlots()); slot++ {
+func (s *Server) processWidthQuota(ctx context.Context, c *pb.Location, rules *pb.ProtectionRules, est *widthEstimator) error {
+	needed := 0
+	protected := make(map[string]int)
+	for slot := 0; slot <= numSlots(c); slot++ {
+		capacity := slotCapacity(c, slot)
 		totalWidth := float32(0)
 		records := []*pbrc.Record{}
 		for _, rp := range c.GetReleasesLocation() {
@@ -235,21 +226,48 @@ func (s *Server) processWidthQuota(ctx context.Context, c *pb.Location) error {
 				if err != nil {
 					return err
 				}
-				totalWidth += rec.GetMetadata().GetRecordWidth()
+				if !inLocation(c, rec) {
+					continue
+				}
+				totalWidth += est.width(rec)
 				records = append(records, rec)
 			}
 		}
 
 		// Sort the record
 		sort.Sort(sales.BySaleOrder(records))
+		candidates, sprotected := sellCandidates(rules, c, records)
 		pointer := 0
-		for pointer < len(records) && totalWidth > c.GetQuota().GetTotalWidth() {
-			up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: records[pointer].GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
-			s.bridge.updateRecord(ctx, up)
-			totalWidth -= records[pointer].GetMetadata().GetRecordWidth()
+		for pointer < len(candidates) && totalWidth > capacity {
+			up := &pbrc.UpdateRecordRequest{Reason: "org-prepare-to-sell", Update: &pbrc.Record{Release: &pbgd.Release{InstanceId: candidates[pointer].GetRelease().InstanceId}, Metadata: &pbrc.ReleaseMetadata{Category: pbrc.ReleaseMetadata_PREPARE_TO_SELL}}}
+			s.updateRecord(ctx, c, "prepare-to-sell", up)
+			totalWidth -= est.width(candidates[pointer])
 			pointer++
 		}
+
+		if totalWidth > capacity {
+			// Count the protected records that would also have to go to fit the slot
+			sold := make(map[int64]bool)
+			for _, r := range candidates[:pointer] {
+				sold[r.GetRelease().GetInstanceId()] = true
+			}
+			for _, r := range records {
+				if totalWidth <= capacity {
+					break
+				}
+				if !sold[r.GetRelease().GetInstanceId()] {
+					totalWidth -= est.width(r)
+					needed++
+				}
+			}
+			for reason, count := range sprotected {
+				protected[reason] += count
+			}
+		}
 	}
 
+	if needed > 0 {
+		return s.nothingSellable(ctx, c, needed, protected)
+	}
 	return nil
 }
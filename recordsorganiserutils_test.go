@@ -53,7 +53,7 @@ func TestSaleQuota(t *testing.T) {
 			&pb.ReleasePlacement{},
 		}}
 	s := getTestServer(".testsalequota")
-	s.processQuota(context.Background(), testLocation)
+	s.processQuota(context.Background(), testLocation, &pb.ProtectionRules{})
 }
 
 func TestFailRecordPull(t *testing.T) {
@@ -68,7 +68,7 @@ func TestFailRecordPull(t *testing.T) {
 		}}
 	s := getTestServer(".testsalequota")
 	s.bridge = &testBridge{failGetRecord: true}
-	err := s.processQuota(context.Background(), testLocation)
+	err := s.processQuota(context.Background(), testLocation, &pb.ProtectionRules{})
 
 	log.Printf("Boing %v", err)
 	if err == nil {
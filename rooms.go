@@ -0,0 +1,67 @@
+package main
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// physicalPath returns where the given slot of a location sits, slots are numbered from 1
+func physicalPath(c *pb.Location, slot int32) *pb.PhysicalPath {
+	if slot > 0 && int(slot) <= len(c.GetSlotDefinitions()) {
+		return c.GetSlotDefinitions()[slot-1].GetPhysical()
+	}
+	return nil
+}
+
+// findPhysicalSlot resolves a path against the rooms in the organisation
+func findPhysicalSlot(org *pb.Organisation, path *pb.PhysicalPath) *pb.PhysicalSlot {
+	for _, room := range org.GetRooms() {
+		if room.GetName() != path.GetRoom() {
+			continue
+		}
+		for _, unit := range room.GetUnits() {
+			if unit.GetName() != path.GetUnit() {
+				continue
+			}
+			for _, shelf := range unit.GetShelves() {
+				if shelf.GetRow() != path.GetRow() {
+					continue
+				}
+				for _, slot := range shelf.GetSlots() {
+					if slot.GetColumn() == path.GetColumn() {
+						return slot
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateRoom adds, replaces or removes a room
+func (s *Server) UpdateRoom(ctx context.Context, req *pb.UpdateRoomRequest) (*pb.UpdateRoomResponse, error) {
+	if req.GetRoom().GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Rooms must be named")
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rooms []*pb.Room
+	for _, room := range org.GetRooms() {
+		if room.GetName() != req.GetRoom().GetName() {
+			rooms = append(rooms, room)
+		}
+	}
+	if !req.GetDelete() {
+		rooms = append(rooms, req.GetRoom())
+	}
+	org.Rooms = rooms
+
+	return &pb.UpdateRoomResponse{Rooms: org.GetRooms()}, s.saveOrg(ctx, org)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestLocateReturnsPath(t *testing.T) {
+	s := getTestServer(".locatePath")
+	ctx := context.Background()
+
+	room := &pb.Room{Name: "living room", Units: []*pb.Unit{{Name: "Kallax B", Shelves: []*pb.Shelf{{Row: 2, Slots: []*pb.PhysicalSlot{{Column: 3, Width: 33, Height: 33}}}}}}}
+	if _, err := s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: room}); err != nil {
+		t.Fatalf("Unable to add room: %v", err)
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		t.Fatalf("Unable to read org: %v", err)
+	}
+	org.Locations = append(org.Locations, &pb.Location{
+		Name:             "TestName",
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1234, Index: 13, Slot: 1}},
+	})
+	if err := s.saveOrg(ctx, org); err != nil {
+		t.Fatalf("Unable to save org: %v", err)
+	}
+
+	path := &pb.PhysicalPath{Room: "living room", Unit: "Kallax B", Row: 2, Column: 3}
+	if _, err := s.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: "TestName", Update: &pb.Location{SlotDefinitions: []*pb.SlotDefinition{{Physical: path}}}}); err != nil {
+		t.Fatalf("Unable to update location: %v", err)
+	}
+
+	resp, err := s.Locate(ctx, &pb.LocateRequest{InstanceId: 1234})
+	if err != nil {
+		t.Fatalf("Unable to locate: %v", err)
+	}
+	if resp.GetPath().GetUnit() != "Kallax B" || resp.GetPath().GetColumn() != 3 || resp.GetPosition() != 14 {
+		t.Errorf("Bad path: %v", resp)
+	}
+	if slotCapacity(resp.GetFoundLocation(), 1) != 33 {
+		t.Errorf("Slot did not pick up the physical width: %v", resp.GetFoundLocation())
+	}
+
+	// Losing the cache shouldn't stop us finding the record
+	s.store = &testStore{fail: true}
+	resp, err = s.Locate(ctx, &pb.LocateRequest{InstanceId: 1234})
+	if err != nil || resp.GetPath().GetUnit() != "Kallax B" || resp.GetPosition() != 14 {
+		t.Errorf("Locate failed without the cache: %v, %v", resp, err)
+	}
+}
+
+func TestUpdateRoomReplaces(t *testing.T) {
+	s := getTestServer(".updateRoom")
+	ctx := context.Background()
+
+	s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: &pb.Room{Name: "study"}})
+	resp, err := s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: &pb.Room{Name: "study", Units: []*pb.Unit{{Name: "Billy"}}}})
+	if err != nil || len(resp.GetRooms()) != 1 || len(resp.GetRooms()[0].GetUnits()) != 1 {
+		t.Errorf("Room was not replaced: %v, %v", resp, err)
+	}
+
+	resp, err = s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: &pb.Room{Name: "study"}, Delete: true})
+	if err != nil || len(resp.GetRooms()) != 0 {
+		t.Errorf("Room was not deleted: %v, %v", resp, err)
+	}
+
+	if _, err := s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: &pb.Room{}}); err == nil {
+		t.Errorf("Unnamed room was accepted")
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// editDistance is the levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = curr[j-1] + 1
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if prev[j-1]+cost < curr[j] {
+				curr[j] = prev[j-1] + cost
+			}
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
+
+// fuzzyScore rates how well the query matches the text, zero is no match
+func fuzzyScore(query, text string) float32 {
+	query = strings.ToLower(strings.TrimSpace(query))
+	text = strings.ToLower(text)
+	if query == "" {
+		return 0
+	}
+
+	words := strings.Fields(text)
+	tokens := strings.Fields(query)
+	matched := float32(0)
+	for _, token := range tokens {
+		for _, word := range words {
+			// Allow a typo in longer words
+			if strings.Contains(word, token) || (len(token) >= 4 && editDistance(word, token) <= 1) {
+				matched++
+				break
+			}
+		}
+	}
+
+	score := 0.8 * matched / float32(len(tokens))
+	if score > 0 && strings.Contains(text, query) {
+		score += 0.2
+	}
+	return score
+}
+
+// normaliseCatno reduces a catalogue number to its letters and digits so that WARP-LP-55 matches warplp55
+func normaliseCatno(catno string) string {
+	return strings.ToLower(strings.ReplaceAll(convertCatno(catno), " ", ""))
+}
+
+func catnoMatches(req *pb.SearchRequest, view *pb.PlacementView) float32 {
+	if req.GetCatno() == "" || normaliseCatno(view.GetCatno()) != normaliseCatno(req.GetCatno()) {
+		return 0
+	}
+	if req.GetLabel() == "" {
+		return 0.9
+	}
+	if strings.EqualFold(view.GetLabel(), req.GetLabel()) {
+		return 1
+	}
+	if strings.Contains(strings.ToLower(view.GetLabel()), strings.ToLower(req.GetLabel())) {
+		return 0.9
+	}
+	return 0
+}
+
+// searchPlacements finds the placed records matching the request, best match first
+func searchPlacements(org *pb.Organisation, cache *pb.SortingCache, req *pb.SearchRequest) []*pb.SearchResult {
+	entries := cacheIndex(cache)
+
+	var results []*pb.SearchResult
+	for _, loc := range org.GetLocations() {
+		for _, view := range locationViews(loc, entries) {
+			result := &pb.SearchResult{View: view, Path: physicalPath(loc, view.GetPlacement().GetSlot())}
+			if req.GetReleaseId() > 0 && view.GetReleaseId() == req.GetReleaseId() {
+				result.Score, result.Matched = 1, "release id"
+			}
+			if score := catnoMatches(req, view); score > result.Score {
+				result.Score, result.Matched = score, "catno"
+			}
+			text := view.GetArtist() + " " + view.GetPlacement().GetTitle() + " " + view.GetLabel()
+			if score := fuzzyScore(req.GetQuery(), text); score > result.Score {
+				result.Score, result.Matched = score, "text"
+			}
+
+			if result.Score > 0 {
+				results = append(results, result)
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].GetScore() > results[j].GetScore()
+	})
+
+	if req.GetLimit() > 0 && int(req.GetLimit()) < len(results) {
+		results = results[:req.GetLimit()]
+	}
+	return results
+}
+
+// Search finds records by release id, catalogue number or text
+func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if req.GetReleaseId() == 0 && req.GetCatno() == "" && strings.TrimSpace(req.GetQuery()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Search needs a release id, catno or query")
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := s.loadCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchResponse{Results: searchPlacements(org, cache, req)}, nil
+}
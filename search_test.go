@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func testSearchData() (*pb.Organisation, *pb.SortingCache) {
+	org := &pb.Organisation{Locations: []*pb.Location{{Name: "test", ReleasesLocation: []*pb.ReleasePlacement{
+		{InstanceId: 1, Slot: 1, Title: "Selected Ambient Works 85-92"},
+		{InstanceId: 2, Slot: 1, Title: "Music Has The Right To Children"},
+		{InstanceId: 3, Slot: 2, Title: "Selected Ambient Works 85-92"},
+	}}}}
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{
+		{InstanceId: 1, ReleaseId: 100, Artist: "Aphex Twin", MainLabel: "Apollo", Catno: "AMB 3922"},
+		{InstanceId: 2, ReleaseId: 200, Artist: "Boards Of Canada", MainLabel: "Warp Records", Catno: "WARPLP55"},
+		{InstanceId: 3, ReleaseId: 100, Artist: "Aphex Twin", MainLabel: "Apollo", Catno: "AMB 3922"},
+	}}
+	return org, cache
+}
+
+func TestSearchByReleaseID(t *testing.T) {
+	org, cache := testSearchData()
+	results := searchPlacements(org, cache, &pb.SearchRequest{ReleaseId: 100})
+	if len(results) != 2 || results[0].GetScore() != 1 || results[1].GetView().GetPlacement().GetSlot() != 2 {
+		t.Errorf("Bad release id search: %v", results)
+	}
+}
+
+func TestSearchByCatno(t *testing.T) {
+	org, cache := testSearchData()
+	results := searchPlacements(org, cache, &pb.SearchRequest{Label: "warp", Catno: "warp-lp-55"})
+	if len(results) != 1 || results[0].GetView().GetPlacement().GetInstanceId() != 2 || results[0].GetMatched() != "catno" {
+		t.Errorf("Bad catno search: %v", results)
+	}
+
+	results = searchPlacements(org, cache, &pb.SearchRequest{Label: "Apollo", Catno: "WARPLP55"})
+	if len(results) != 0 {
+		t.Errorf("Catno on the wrong label matched: %v", results)
+	}
+}
+
+func TestSearchByText(t *testing.T) {
+	org, cache := testSearchData()
+	results := searchPlacements(org, cache, &pb.SearchRequest{Query: "boards of canda", Limit: 1})
+	if len(results) != 1 || results[0].GetView().GetPlacement().GetInstanceId() != 2 {
+		t.Errorf("Bad fuzzy search: %v", results)
+	}
+
+	results = searchPlacements(org, cache, &pb.SearchRequest{Query: "aphex ambient"})
+	if len(results) != 2 || results[0].GetScore() < 0.8 {
+		t.Errorf("Bad text search: %v", results)
+	}
+}
+
+func TestSearchNeedsQuery(t *testing.T) {
+	s := getTestServer(".searchEmpty")
+	if _, err := s.Search(context.Background(), &pb.SearchRequest{}); err == nil {
+		t.Errorf("Empty search did not fail")
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// sizeClass works out the physical size of a record from its formats
+func sizeClass(rec *pbrc.Record) string {
+	// Descriptions are more specific than the format name (a Vinyl can be a 7")
+	for _, format := range rec.GetRelease().GetFormats() {
+		for _, desc := range format.GetDescriptions() {
+			switch desc {
+			case "7\"":
+				return "7"
+			case "10\"":
+				return "10"
+			case "12\"", "LP":
+				return "12"
+			}
+		}
+	}
+
+	for _, format := range rec.GetRelease().GetFormats() {
+		switch format.GetName() {
+		case "10\"":
+			return "10"
+		case "LP", "Vinyl":
+			return "12"
+		case "CD", "CDr":
+			return "CD"
+		case "Cassette":
+			return "Cassette"
+		}
+	}
+
+	return ""
+}
+
+type sizeGroup struct {
+	route   *pb.SizeRoute
+	records []*pbrc.Record
+	gaps    []int
+}
+
+// routeBySize splits the records into the main group and one group per size route,
+// hard gaps are kept against the main group
+func routeBySize(c *pb.Location, records []*pbrc.Record, gaps []int) []*sizeGroup {
+	main := &sizeGroup{}
+	groups := []*sizeGroup{main}
+	routed := make(map[string]*sizeGroup)
+	for _, route := range c.GetSizeRoutes() {
+		if _, ok := routed[route.GetSizeClass()]; !ok {
+			routed[route.GetSizeClass()] = &sizeGroup{route: route}
+			groups = append(groups, routed[route.GetSizeClass()])
+		}
+	}
+
+	isGap := make(map[int]bool)
+	for _, gap := range gaps {
+		isGap[gap] = true
+	}
+
+	// A gap in front of a routed record moves on to the next record left behind
+	pendingGap := false
+	for i, r := range records {
+		pendingGap = pendingGap || isGap[i]
+		if group, ok := routed[sizeClass(r)]; ok {
+			group.records = append(group.records, r)
+			continue
+		}
+		if pendingGap {
+			main.gaps = append(main.gaps, len(main.records))
+			pendingGap = false
+		}
+		main.records = append(main.records, r)
+	}
+
+	return groups
+}
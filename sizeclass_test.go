@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestSizeClass(t *testing.T) {
+	tests := []struct {
+		formats []*pbd.Format
+		class   string
+	}{
+		{[]*pbd.Format{{Name: "Vinyl", Descriptions: []string{"7\"", "Single"}}}, "7"},
+		{[]*pbd.Format{{Name: "Vinyl", Descriptions: []string{"10\""}}}, "10"},
+		{[]*pbd.Format{{Name: "Vinyl", Descriptions: []string{"LP", "Album"}}}, "12"},
+		{[]*pbd.Format{{Name: "Vinyl"}}, "12"},
+		{[]*pbd.Format{{Name: "CD"}}, "CD"},
+		{[]*pbd.Format{{Name: "File"}}, ""},
+	}
+
+	for _, tt := range tests {
+		if class := sizeClass(&pbrc.Record{Release: &pbd.Release{Formats: tt.formats}}); class != tt.class {
+			t.Errorf("Bad size class for %v: %v (expected %v)", tt.formats, class, tt.class)
+		}
+	}
+}
+
+func TestRouteBySize(t *testing.T) {
+	seven := []*pbd.Format{{Name: "Vinyl", Descriptions: []string{"7\""}}}
+	twelve := []*pbd.Format{{Name: "Vinyl", Descriptions: []string{"LP"}}}
+	records := []*pbrc.Record{
+		{Release: &pbd.Release{InstanceId: 1, Formats: twelve}},
+		{Release: &pbd.Release{InstanceId: 2, Formats: seven}},
+		{Release: &pbd.Release{InstanceId: 3, Formats: twelve}},
+	}
+	c := &pb.Location{SizeRoutes: []*pb.SizeRoute{{SizeClass: "7", StartSlot: 5, SubLocation: "singles"}}}
+
+	groups := routeBySize(c, records, []int{1})
+	if len(groups) != 2 || len(groups[0].records) != 2 || len(groups[1].records) != 1 {
+		t.Fatalf("Bad routing: %v", groups)
+	}
+	if len(groups[0].gaps) != 1 || groups[0].gaps[0] != 1 {
+		t.Errorf("Gap in front of a routed record was not kept: %v", groups[0].gaps)
+	}
+	if groups[1].route.GetSubLocation() != "singles" {
+		t.Errorf("Bad route: %v", groups[1].route)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"golang.org/x/net/context"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// defaultSleeveFactors are the multipliers we've measured for each sleeve
+func defaultSleeveFactors() map[int32]float32 {
+	return map[int32]float32{
+		int32(pbrc.ReleaseMetadata_SLEEVE_UNKNOWN):            1.18,
+		int32(pbrc.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP): 1.26,
+		int32(pbrc.ReleaseMetadata_BAGS_UNLIMITED_PLAIN):      1.26,
+		int32(pbrc.ReleaseMetadata_CUSTOM):                    1,
+		int32(pbrc.ReleaseMetadata_BOX_SET):                   1,
+		int32(pbrc.ReleaseMetadata_VINYL_STORAGE_NO_INNER):    1.4,
+		int32(pbrc.ReleaseMetadata_FIXED):                     1,
+	}
+}
+
+func sleeveFactor(factors map[int32]float32, sleeve pbrc.ReleaseMetadata_SleeveState) float32 {
+	if val, ok := factors[int32(sleeve)]; ok && val > 0 {
+		return val
+	}
+	return 1
+}
+
+// sleeveAdjust converts a spine width into the width taken up on the shelf
+func sleeveAdjust(width float32, sleeve pbrc.ReleaseMetadata_SleeveState, factors map[int32]float32) float32 {
+	return width * sleeveFactor(factors, sleeve)
+}
+
+// SetSleeveFactor sets the multiplier for a given sleeve
+func (s *Server) SetSleeveFactor(ctx context.Context, req *pb.SetSleeveFactorRequest) (*pb.SetSleeveFactorResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetFactor() > 0 {
+		org.SleeveFactors[req.GetSleeve()] = req.GetFactor()
+	} else if val, ok := defaultSleeveFactors()[req.GetSleeve()]; ok {
+		org.SleeveFactors[req.GetSleeve()] = val
+	} else {
+		delete(org.SleeveFactors, req.GetSleeve())
+	}
+
+	return &pb.SetSleeveFactorResponse{SleeveFactors: org.GetSleeveFactors()}, s.saveOrg(ctx, org)
+}
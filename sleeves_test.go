@@ -0,0 +1,52 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestAdjustMatchesFactors(t *testing.T) {
+	s := InitTestServer()
+	tests := []struct {
+		from, to pbrc.ReleaseMetadata_SleeveState
+		factor   float32
+	}{
+		{pbrc.ReleaseMetadata_BOX_SET, pbrc.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP, 1.26},
+		{pbrc.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP, pbrc.ReleaseMetadata_VINYL_STORAGE_NO_INNER, 1.4 / 1.26},
+		{pbrc.ReleaseMetadata_SLEEVE_UNKNOWN, pbrc.ReleaseMetadata_BOX_SET, 1 / 1.18},
+		{pbrc.ReleaseMetadata_CUSTOM, pbrc.ReleaseMetadata_FIXED, 1},
+	}
+
+	for _, tt := range tests {
+		val := s.adjust(10, tt.from, tt.to, defaultSleeveFactors())
+		if math.Abs(float64(val-10*tt.factor)) > 0.001 {
+			t.Errorf("Bad adjustment %v -> %v: %v", tt.from, tt.to, val)
+		}
+	}
+
+	if s.IssueCount > 0 {
+		t.Errorf("Adjustment raised an issue")
+	}
+}
+
+func TestSetSleeveFactor(t *testing.T) {
+	s := getTestServer(".setSleeveFactor")
+
+	resp, err := s.SetSleeveFactor(context.Background(), &pb.SetSleeveFactorRequest{Sleeve: int32(pbrc.ReleaseMetadata_CUSTOM), Factor: 1.5})
+	if err != nil {
+		t.Fatalf("Unable to set factor: %v", err)
+	}
+	if resp.GetSleeveFactors()[int32(pbrc.ReleaseMetadata_CUSTOM)] != 1.5 || resp.GetSleeveFactors()[int32(pbrc.ReleaseMetadata_VINYL_STORAGE_NO_INNER)] != 1.4 {
+		t.Errorf("Bad factors: %v", resp)
+	}
+
+	resp, err = s.SetSleeveFactor(context.Background(), &pb.SetSleeveFactorRequest{Sleeve: int32(pbrc.ReleaseMetadata_CUSTOM)})
+	if err != nil || resp.GetSleeveFactors()[int32(pbrc.ReleaseMetadata_CUSTOM)] != 1 {
+		t.Errorf("Factor was not reset: %v, %v", resp, err)
+	}
+}
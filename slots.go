@@ -0,0 +1,67 @@
+package main
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// slotCapacity returns the width available in the given slot, slots are numbered from 1
+func slotCapacity(c *pb.Location, slot int) float32 {
+	if slot > 0 && slot <= len(c.GetSlotDefinitions()) && c.GetSlotDefinitions()[slot-1].GetWidth() > 0 {
+		return c.GetSlotDefinitions()[slot-1].GetWidth()
+	}
+	return c.GetQuota().GetTotalWidth()
+}
+
+// numSlots returns the number of slots in the location; slots beyond the definitions
+// fall back to the quota's width, so they still count
+func numSlots(c *pb.Location) int {
+	return max(len(c.GetSlotDefinitions()), int(c.GetSlots()))
+}
+
+// slotContents lists what should be in the given slot of the location
+func slotContents(loc *pb.Location, slot int32, cache *pb.SortingCache) *pb.GetSlotResponse {
+	resp := &pb.GetSlotResponse{Capacity: slotCapacity(loc, int(slot)), Path: physicalPath(loc, slot)}
+
+	entries := cacheIndex(cache)
+	for _, rp := range loc.GetReleasesLocation() {
+		if rp.GetSlot() == slot {
+			resp.Contents = append(resp.Contents, placementView(loc, rp, entries[rp.GetInstanceId()]))
+			resp.TotalWidth += rp.GetDeterminedWidth()
+		}
+	}
+	sort.SliceStable(resp.Contents, func(i, j int) bool {
+		return resp.Contents[i].GetPlacement().GetIndex() < resp.Contents[j].GetPlacement().GetIndex()
+	})
+
+	if len(resp.Contents) > 0 {
+		resp.First = resp.Contents[0]
+		resp.Last = resp.Contents[len(resp.Contents)-1]
+	}
+	return resp
+}
+
+// GetSlot returns what should be in a given slot
+func (s *Server) GetSlot(ctx context.Context, req *pb.GetSlotRequest) (*pb.GetSlotResponse, error) {
+	if req.GetSlot() < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Slots are numbered from 1")
+	}
+
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, loc := range org.GetLocations() {
+		if loc.GetName() == req.GetLocation() {
+			return slotContents(loc, req.GetSlot(), s.displayCache(ctx)), nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "Unable to find location %v", req.GetLocation())
+}
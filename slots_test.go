@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestSlotCapacity(t *testing.T) {
+	c := &pb.Location{Quota: &pb.Quota{TotalWidth: 20}, Slots: 4,
+		SlotDefinitions: []*pb.SlotDefinition{{Width: 30}, {Width: 10, HeightClass: "short"}}}
+
+	if slotCapacity(c, 1) != 30 || slotCapacity(c, 2) != 10 {
+		t.Errorf("Bad defined capacity: %v, %v", slotCapacity(c, 1), slotCapacity(c, 2))
+	}
+	if slotCapacity(c, 3) != 20 {
+		t.Errorf("Undefined slot should fall back to the quota: %v", slotCapacity(c, 3))
+	}
+	if numSlots(c) != 4 {
+		t.Errorf("Slots beyond the definitions were not counted: %v", numSlots(c))
+	}
+}
+
+func TestWidthQuotaChecksUndefinedSlots(t *testing.T) {
+	s := getTestServer(".widthQuotaUndefined")
+	s.bridge = testBridge{keepers: true}
+
+	loc := &pb.Location{
+		Name:             "overflow",
+		Slots:            3,
+		FolderIds:        []int32{0},
+		Quota:            &pb.Quota{TotalWidth: 5},
+		SlotDefinitions:  []*pb.SlotDefinition{{Width: 30}},
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}, {InstanceId: 2, Slot: 3}, {InstanceId: 3, Slot: 3}},
+	}
+	cache := &pb.SortingCache{}
+	for i := int64(1); i <= 3; i++ {
+		appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: i + 100}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 4}}, nil, nil)
+	}
+
+	// Only the third slot, which has no definition, is over its width
+	err := s.processWidthQuota(context.Background(), loc, &pb.ProtectionRules{}, newWidthEstimator(cache, defaultSleeveFactors(), nil))
+	if status.Convert(err).Code() != codes.FailedPrecondition {
+		t.Errorf("Overflow slot was not checked: %v", err)
+	}
+}
+
+func TestSplitUsesSlotCapacity(t *testing.T) {
+	s := InitTestServer()
+	c := &pb.Location{Name: "test", Quota: &pb.Quota{TotalWidth: 100},
+		SlotDefinitions: []*pb.SlotDefinition{{Width: 2}, {Width: 4}}}
+
+	var records []*pbrc.Record
+	for i := int64(1); i <= 6; i++ {
+		records = append(records, &pbrc.Record{Release: &pbd.Release{InstanceId: i}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 1, Sleeve: pbrc.ReleaseMetadata_CUSTOM}})
+	}
+
+	slots := s.Split(context.Background(), c, records, []int{}, newWidthEstimator(&pb.SortingCache{}, defaultSleeveFactors(), nil), 0)
+	if len(slots) != 2 || len(slots[0]) != 2 || len(slots[1]) != 4 {
+		t.Errorf("Bad split: %v", slots)
+	}
+}
+
+func TestSlotContents(t *testing.T) {
+	loc := &pb.Location{Name: "test", Quota: &pb.Quota{TotalWidth: 30}, ReleasesLocation: []*pb.ReleasePlacement{
+		{InstanceId: 1, Slot: 1, Index: 0, DeterminedWidth: 3},
+		{InstanceId: 2, Slot: 2, Index: 1, DeterminedWidth: 4},
+		{InstanceId: 3, Slot: 2, Index: 0, DeterminedWidth: 5},
+	}}
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{{InstanceId: 3, MainLabel: "Warp", Catno: "WARP12"}}}
+
+	resp := slotContents(loc, 2, cache)
+	if len(resp.GetContents()) != 2 || resp.GetTotalWidth() != 9 || resp.GetCapacity() != 30 {
+		t.Errorf("Bad slot: %v", resp)
+	}
+	if resp.GetFirst().GetCatno() != "WARP12" || resp.GetLast().GetPlacement().GetInstanceId() != 2 {
+		t.Errorf("Bad bookends: %v, %v", resp.GetFirst(), resp.GetLast())
+	}
+
+	if resp := slotContents(loc, 3, cache); len(resp.GetContents()) != 0 || resp.GetFirst() != nil {
+		t.Errorf("Empty slot has contents: %v", resp)
+	}
+}
+
+func TestGetSlotWithoutCache(t *testing.T) {
+	s := getTestServer(".getSlotNoCache")
+	ctx := context.Background()
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		t.Fatalf("Unable to read org: %v", err)
+	}
+	org.Locations = append(org.Locations, &pb.Location{Name: "test", ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}}})
+	if err := s.saveOrg(ctx, org); err != nil {
+		t.Fatalf("Unable to save org: %v", err)
+	}
+
+	s.store = &testStore{fail: true}
+	resp, err := s.GetSlot(ctx, &pb.GetSlotRequest{Location: "test", Slot: 1})
+	if err != nil || len(resp.GetContents()) != 1 {
+		t.Errorf("Slot failed without the cache: %v, %v", resp, err)
+	}
+}
+
+func TestGetSlotMissingLocation(t *testing.T) {
+	s := getTestServer(".getSlotMissing")
+	if _, err := s.GetSlot(context.Background(), &pb.GetSlotRequest{Location: "madeup", Slot: 1}); err == nil {
+		t.Errorf("Missing location returned a slot")
+	}
+}
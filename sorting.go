@@ -2,22 +2,18 @@ package main
 
 import (
 	"fmt"
-	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
 
-	"github.com/brotherlogic/godiscogs"
-
 	pb "github.com/brotherlogic/godiscogs/proto"
 	pbrc "github.com/brotherlogic/recordcollection/proto"
 	pbro "github.com/brotherlogic/recordsorganiser/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/net/context"
-
-	"github.com/fvbommel/sortorder"
 )
 
 // ByDateAdded allows sorting of releases by the date they were added
@@ -65,42 +61,14 @@ func (a ByDateMoved) Less(i, j int) bool {
 	return strings.Compare(a[i].Release.Title, a[j].Release.Title) < 0
 }
 
-// ByLabelCat allows sorting of releases by the date they were added
-type ByLabelCat struct {
-	records    []*pbrc.Record
-	extractors map[int32]string
-	logger     func(context.Context, string)
-	cache      *pbro.SortingCache
-}
-
-func (a ByLabelCat) Len() int      { return len(a.records) }
-func (a ByLabelCat) Swap(i, j int) { a.records[i], a.records[j] = a.records[j], a.records[i] }
-func (a ByLabelCat) Less(i, j int) bool {
-	return sortByLabelCat(a.records[i].GetRelease(), a.records[j].GetRelease(), a.extractors, a.logger, a.cache) < 0
-}
-
-// ByLabelCat allows sorting of releases by the date they were added
-type ByCachedLabelCat struct {
-	records []int64
-	cache   *pbro.SortingCache
-}
-
-func (a ByCachedLabelCat) Len() int      { return len(a.records) }
-func (a ByCachedLabelCat) Swap(i, j int) { a.records[i], a.records[j] = a.records[j], a.records[i] }
-func (a ByCachedLabelCat) Less(i, j int) bool {
-	return sortByLabelCatCached(getEntry(a.cache, a.records[i]), getEntry(a.cache, a.records[j]), a.cache) < 0
-}
+var splitRegexp = regexp.MustCompile("[0-9]+|[a-z]+|[A-Z]+")
 
 func split(str string) []string {
-	return regexp.MustCompile("[0-9]+|[a-z]+|[A-Z]+").FindAllString(str, -1)
+	return splitRegexp.FindAllString(str, -1)
 }
 
-func doExtractorSplit(label *pb.Label, ex map[int32]string, logger func(context.Context, string)) []string {
-	if val, ok := ex[label.Id]; ok {
-		r, err := regexp.Compile(val)
-		if err != nil {
-			return make([]string, 0)
-		}
+func doExtractorSplit(label *pb.Label, ex map[int32]*regexp.Regexp) []string {
+	if r, ok := ex[label.Id]; ok {
 		vals := r.FindAllStringSubmatch(label.Catno, -1)
 		ret := make([]string, 0)
 		for _, pair := range vals {
@@ -112,49 +80,49 @@ func doExtractorSplit(label *pb.Label, ex map[int32]string, logger func(context.
 	return make([]string, 0)
 }
 
-// Sorts by label and then catalogue number
-func sortByLabelCatCached(rel1, rel2 *pbro.CacheEntry, cache *pbro.SortingCache) int {
-	bits1 := strings.Split(rel1.GetEntry()["BY_LABEL"], "|")
-	bits2 := strings.Split(rel2.GetEntry()["BY_LABEL"], "|")
-
-	val := strings.Compare(bits1[0], bits2[0])
-	if val != 0 {
-		return val
+// labelKey builds the key used to sort a release by label and catalogue number
+func labelKey(rel *pb.Release, extractors map[int32]*regexp.Regexp, rules *labelRules) *pbro.LabelSortKey {
+	if len(rel.GetLabels()) == 0 {
+		return &pbro.LabelSortKey{Title: rel.GetTitle()}
 	}
 
-	if sortorder.NaturalLess(bits1[1], bits2[1]) {
-		return -1
-	}
-	if bits1[1] == bits2[1] {
-		return strings.Compare(bits1[2], bits2[2])
+	label := rules.mainLabel(rel)
+	return &pbro.LabelSortKey{
+		Labelled:  true,
+		Family:    strings.ToLower(rules.family(label)),
+		Label:     strings.ToLower(rules.canonical(label)),
+		Parts:     split(strings.ToLower(label.GetCatno())),
+		Extracted: doExtractorSplit(label, extractors),
+		Title:     rel.GetTitle(),
 	}
-	return 1
 }
 
-// Sorts by label and then catalogue number
-func sortByLabelCat(rel1, rel2 *pb.Release, extractors map[int32]string, logger func(context.Context, string), cache *pbro.SortingCache) int {
-
-	if len(rel1.Labels) == 0 {
-		return -1
-	}
-	if len(rel2.Labels) == 0 {
+// compareLabelKeys orders two label keys; both the live and the cached sort use this
+func compareLabelKeys(key1, key2 *pbro.LabelSortKey) int {
+	// Unlabelled releases come first, ordered by title
+	if key1.GetLabelled() != key2.GetLabelled() {
+		if !key1.GetLabelled() {
+			return -1
+		}
 		return 1
 	}
+	if !key1.GetLabelled() {
+		return strings.Compare(key1.GetTitle(), key2.GetTitle())
+	}
 
-	label1 := godiscogs.GetMainLabel(rel1.Labels)
-	label2 := godiscogs.GetMainLabel(rel2.Labels)
-
-	labelSort := strings.Compare(strings.ToLower(label1.GetName()), strings.ToLower(label2.GetName()))
+	familySort := strings.Compare(key1.GetFamily(), key2.GetFamily())
+	if familySort != 0 {
+		return familySort
+	}
+	labelSort := strings.Compare(key1.GetLabel(), key2.GetLabel())
 	if labelSort != 0 {
 		return labelSort
 	}
 
-	cat1Elems := doExtractorSplit(label1, extractors, logger)
-	cat2Elems := doExtractorSplit(label2, extractors, logger)
-
+	// Extracted parts are only comparable if the extractor found the same shape in both
+	cat1Elems, cat2Elems := key1.GetExtracted(), key2.GetExtracted()
 	if len(cat1Elems) == 0 || len(cat1Elems) != len(cat2Elems) {
-		cat1Elems = split(strings.ToLower(label1.Catno))
-		cat2Elems = split(strings.ToLower(label2.Catno))
+		cat1Elems, cat2Elems = key1.GetParts(), key2.GetParts()
 	}
 
 	toCheck := len(cat1Elems)
@@ -180,8 +148,49 @@ func sortByLabelCat(rel1, rel2 *pb.Release, extractors map[int32]string, logger
 	}
 
 	//Fallout to sorting by title
-	titleComp := strings.Compare(rel1.Title, rel2.Title)
-	return titleComp
+	return strings.Compare(key1.GetTitle(), key2.GetTitle())
+}
+
+type labelKeyed struct {
+	key    *pbro.LabelSortKey
+	record *pbrc.Record
+	id     int64
+}
+
+type byLabelKey []labelKeyed
+
+func (a byLabelKey) Len() int           { return len(a) }
+func (a byLabelKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byLabelKey) Less(i, j int) bool { return compareLabelKeys(a[i].key, a[j].key) < 0 }
+
+// sortByLabel sorts records by label and catalogue number, building each key once up front
+func sortByLabel(records []*pbrc.Record, extractors map[int32]*regexp.Regexp, rules *labelRules) {
+	keyed := make(byLabelKey, len(records))
+	for i, rec := range records {
+		keyed[i] = labelKeyed{key: labelKey(rec.GetRelease(), extractors, rules), record: rec}
+	}
+	sort.Sort(keyed)
+	for i, k := range keyed {
+		records[i] = k.record
+	}
+}
+
+// sortCachedByLabel sorts instance ids by the label keys held in the cache
+func sortCachedByLabel(ids []int64, cache *pbro.SortingCache) {
+	index := cacheIndex(cache)
+	keyed := make(byLabelKey, len(ids))
+	for i, id := range ids {
+		keyed[i] = labelKeyed{key: index[id].GetLabelKey(), id: id}
+	}
+	sort.Sort(keyed)
+	for i, k := range keyed {
+		ids[i] = k.id
+	}
+}
+
+// Sorts by label and then catalogue number
+func sortByLabelCat(rel1, rel2 *pb.Release, extractors map[int32]*regexp.Regexp, rules *labelRules) int {
+	return compareLabelKeys(labelKey(rel1, extractors, rules), labelKey(rel2, extractors, rules))
 }
 
 // ByEarliestReleaseDate allows sorting by the earliest release date
@@ -212,29 +221,6 @@ func (a ByFolderThenRelease) Less(i, j int) bool {
 	return strings.Compare(a[i].GetRelease().Title, a[j].GetRelease().Title) < 0
 }
 
-func getFormatWidth(r *pbrc.Record, bwidth float64) float32 {
-	// Use the spine width if we have it
-	if r.GetMetadata().GetRecordWidth() > 0 {
-		// Make the adjustment for DS_F records
-		if r.GetMetadata().GetSleeve() == pbrc.ReleaseMetadata_BAGS_UNLIMITED_PLAIN ||
-			r.GetMetadata().GetSleeve() == pbrc.ReleaseMetadata_VINYL_STORAGE_DOUBLE_FLAP {
-			return r.GetMetadata().GetRecordWidth() * 1.26
-		}
-
-		if r.GetMetadata().GetSleeve() == pbrc.ReleaseMetadata_SLEEVE_UNKNOWN {
-			return r.GetMetadata().GetRecordWidth() * 1.18
-		}
-
-		if r.GetMetadata().GetSleeve() == pbrc.ReleaseMetadata_VINYL_STORAGE_NO_INNER {
-			return r.GetMetadata().GetRecordWidth() * 1.4
-		}
-
-		return r.GetMetadata().GetRecordWidth()
-	}
-
-	return float32(bwidth)
-}
-
 var (
 	fstart = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "recordsorganiser_slot_start",
@@ -242,37 +228,16 @@ var (
 	}, []string{"location", "folder"})
 )
 
-// Split splits a releases list into buckets
-func (s *Server) Split(ctx context.Context, loc string, releases []*pbrc.Record, n float32, maxw float32, hardgap []int, allowAdjust bool, bwidth float64) [][]*pbrc.Record {
+// Split splits a releases list into buckets, starting after offset slots
+func (s *Server) Split(ctx context.Context, c *pbro.Location, releases []*pbrc.Record, hardgap []int, est *widthEstimator, offset int) [][]*pbrc.Record {
 	var solution [][]*pbrc.Record
+	loc := c.GetName()
+	allowAdjust := c.GetAllowAdjust()
 
-	var counts []float32
-	count := float32(0)
-	tslots := 0
-	for i, rel := range releases {
-		for _, gap := range hardgap {
-			if i == gap {
-				nslots := int(math.Ceil(float64(count) / float64(maxw)))
-				tslots += nslots
-				poss := float32(count/float32(nslots)) + 10.0
-				if poss > maxw {
-					poss = maxw
-				}
-				counts = append(counts, maxw)
-				count = 0
-			}
-		}
-		count += getFormatWidth(rel, bwidth)
-	}
-
-	counts = append(counts, maxw)
-
-	s.CtxLog(ctx, fmt.Sprintf("HERE %v: %v", loc, counts))
-
-	version := 0
 	currentValue := float32(0.0)
 	var currentReleases []*pbrc.Record
 	for i := range releases {
+		capacity := slotCapacity(c, offset+len(solution)+1)
 		found := false
 		for _, gap := range hardgap {
 			if i == gap {
@@ -284,17 +249,16 @@ func (s *Server) Split(ctx context.Context, loc string, releases []*pbrc.Record,
 			solution = append(solution, currentReleases)
 			currentReleases = make([]*pbrc.Record, 0)
 			currentValue = 0
-			version++
-		} else if currentValue+getFormatWidth(releases[i], bwidth) > counts[version] {
+		} else if currentValue+est.width(releases[i]) > capacity {
 
-			s.CtxLog(ctx, fmt.Sprintf("Flipping %v @ %v / %v, because %v + %v is greater than %v -> %v", loc, len(solution), i, currentValue, getFormatWidth(releases[i], bwidth), counts[version], counts))
+			s.CtxLog(ctx, fmt.Sprintf("Flipping %v @ %v / %v, because %v + %v is greater than %v", loc, len(solution), i, currentValue, est.width(releases[i]), capacity))
 
-			if allowAdjust && i < len(releases)-1 && currentValue+getFormatWidth(releases[i+1], bwidth) < counts[version] {
+			if allowAdjust && i < len(releases)-1 && currentValue+est.width(releases[i+1]) < capacity {
 				releases[i], releases[i+1] = releases[i+1], releases[i]
-			} else if allowAdjust && i < len(releases)-2 && currentValue+getFormatWidth(releases[i+2], bwidth) < counts[version] {
+			} else if allowAdjust && i < len(releases)-2 && currentValue+est.width(releases[i+2]) < capacity {
 				releases[i], releases[i+2] = releases[i+2], releases[i]
 				releases[i+1], releases[i+2] = releases[i+2], releases[i+1] // Correct misorder
-			} else if allowAdjust && i < len(releases)-3 && currentValue+getFormatWidth(releases[i+3], bwidth) < counts[version] {
+			} else if allowAdjust && i < len(releases)-3 && currentValue+est.width(releases[i+3]) < capacity {
 				releases[i], releases[i+3] = releases[i+3], releases[i]
 				releases[i+1], releases[i+3] = releases[i+3], releases[i+1] // Correct misorder
 				releases[i+2], releases[i+3] = releases[i+3], releases[i+2] // Correct misorder
@@ -306,7 +270,7 @@ func (s *Server) Split(ctx context.Context, loc string, releases []*pbrc.Record,
 		}
 
 		currentReleases = append(currentReleases, releases[i])
-		currentValue += getFormatWidth(releases[i], bwidth)
+		currentValue += est.width(releases[i])
 
 	}
 	solution = append(solution, currentReleases)
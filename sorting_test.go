@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -53,7 +54,7 @@ func TestSortByLabelCat(t *testing.T) {
 		&pbrc.Record{Release: &pbd.Release{Id: 4, Labels: []*pbd.Label{&pbd.Label{Name: "TestA"}}}, Metadata: &pbrc.ReleaseMetadata{DateAdded: 123}},
 	}
 
-	sort.Sort(ByLabelCat{releases, make(map[int32]string), testLog, &pb.SortingCache{}})
+	sortByLabel(releases, nil, nil)
 
 	if releases[0].Release.Id != 4 {
 		t.Errorf("Releases are not correctly ordered: %v", releases)
@@ -67,7 +68,7 @@ func TestSortByLabelCatWhenCatImbalance(t *testing.T) {
 		&pbrc.Record{Release: &pbd.Release{Id: 4, Labels: []*pbd.Label{&pbd.Label{Name: "TestA"}}}, Metadata: &pbrc.ReleaseMetadata{DateAdded: 123}},
 	}
 
-	sort.Sort(ByLabelCat{releases, make(map[int32]string), testLog, &pb.SortingCache{}})
+	sortByLabel(releases, nil, nil)
 
 	if releases[0].Release.Id != 4 {
 		t.Errorf("Releases are not correctly ordered: %v", releases)
@@ -81,13 +82,25 @@ func TestSortByLabelCatNoLabels(t *testing.T) {
 		&pbrc.Record{Release: &pbd.Release{Id: 4}, Metadata: &pbrc.ReleaseMetadata{DateAdded: 123}},
 	}
 
-	sort.Sort(ByLabelCat{releases, make(map[int32]string), testLog, &pb.SortingCache{}})
+	sortByLabel(releases, nil, nil)
 
-	if releases[0].Release.Id != 4 {
+	if releases[2].Release.Id != 3 {
 		t.Errorf("Releases are not correctly ordered: %v", releases)
 	}
 }
 
+func TestSortUnlabelledByTitle(t *testing.T) {
+	key1 := &pb.LabelSortKey{Title: "Alpha"}
+	key2 := &pb.LabelSortKey{Title: "Beta"}
+
+	if compareLabelKeys(key1, key2) >= 0 || compareLabelKeys(key2, key1) <= 0 {
+		t.Errorf("Unlabelled keys were not ordered by title")
+	}
+	if compareLabelKeys(key1, key1) != 0 {
+		t.Errorf("Key did not match itself")
+	}
+}
+
 func TestSortByDateAddedWithFallback(t *testing.T) {
 	releases := []*pbrc.Record{
 		&pbrc.Record{Release: &pbd.Release{Title: "Second", Id: 2}, Metadata: &pbrc.ReleaseMetadata{DateAdded: 124}},
@@ -129,19 +142,19 @@ var defaultComp = []struct {
 
 func TestSortingByLabelCat(t *testing.T) {
 	for _, tt := range sortTests {
-		sValue := sortByLabelCat(&tt.r1, &tt.r2, make(map[int32]string), testLog, &pb.SortingCache{})
+		sValue := sortByLabelCat(&tt.r1, &tt.r2, nil, nil)
 		if sValue >= 0 {
 			t.Errorf("%v should come before %v (%v)", tt.r1, tt.r2, sValue)
 		}
-		sValueR := sortByLabelCat(&tt.r2, &tt.r1, make(map[int32]string), testLog, &pb.SortingCache{})
+		sValueR := sortByLabelCat(&tt.r2, &tt.r1, nil, nil)
 		if sValueR <= 0 {
 			t.Errorf("%v should come before %v (%v)", tt.r1, tt.r2, sValueR)
 		}
 	}
 
 	tt := defaultComp[0]
-	sValue := sortByLabelCat(&tt.r1, &tt.r2, make(map[int32]string), testLog, &pb.SortingCache{})
-	sValue2 := sortByLabelCat(&tt.r2, &tt.r1, make(map[int32]string), testLog, &pb.SortingCache{})
+	sValue := sortByLabelCat(&tt.r1, &tt.r2, nil, nil)
+	sValue2 := sortByLabelCat(&tt.r2, &tt.r1, nil, nil)
 	if sValue != 0 || sValue2 != 0 {
 		t.Errorf("Default is not zero: %v and %v", sValue, sValue2)
 	}
@@ -181,38 +194,10 @@ func TestSortByFolderThenMasterReleaseDate(t *testing.T) {
 	}
 }
 
-func TestGetFormatWidth(t *testing.T) {
-	v := getFormatWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Labels: []*pbd.Label{&pbd.Label{Name: "Death Waltz Recording Company"}}}}, 2.0)
-	if v != 2.0 {
-		t.Errorf("Bad width: %v", v)
-	}
-}
-
-func TestGetFormatWidthForNowAgain(t *testing.T) {
-	v := getFormatWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Labels: []*pbd.Label{&pbd.Label{Name: "Now-Again Records"}}}}, 2.0)
-	if v != 2.0 {
-		t.Errorf("Bad width: %v", v)
-	}
-}
-
-func TestGetFormatWidthForBox(t *testing.T) {
-	v := getFormatWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 4, Formats: []*pbd.Format{&pbd.Format{Text: "Boxset"}}}}, 2.0)
-	if v != 2.0 {
-		t.Errorf("Bad width: %v", v)
-	}
-}
-
-func TestGetFormatWidthForGatefold(t *testing.T) {
-	v := getFormatWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Formats: []*pbd.Format{&pbd.Format{Text: "Gatefold"}}}}, 2.0)
-	if v != 2.0 {
-		t.Errorf("Bad width: %v", v)
-	}
-}
-
 func TestExtractorSplit(t *testing.T) {
 	m := make(map[int32]string)
 	m[int32(123)] = "(5\\d\\d)"
-	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, m, testLog)
+	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, compileExtractors(m))
 
 	if len(vals) != 1 {
 		t.Errorf("Bad extraction: %v", vals)
@@ -222,7 +207,7 @@ func TestExtractorSplit(t *testing.T) {
 func TestExtractorSplitBadExtractor(t *testing.T) {
 	m := make(map[int32]string)
 	m[int32(123)] = "(5\\d\\d"
-	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, m, testLog)
+	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, compileExtractors(m))
 
 	if len(vals) != 0 {
 		t.Errorf("Bad extraction: %v", vals)
@@ -231,7 +216,7 @@ func TestExtractorSplitBadExtractor(t *testing.T) {
 
 func TestExtractorSplitNoCandidates(t *testing.T) {
 	m := make(map[int32]string)
-	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, m, testLog)
+	vals := doExtractorSplit(&pbd.Label{Catno: "MPI-503", Id: 123}, compileExtractors(m))
 
 	if len(vals) != 0 {
 		t.Errorf("Bad extraction: %v", vals)
@@ -256,13 +241,13 @@ func TestLoadedSorting(t *testing.T) {
 			}
 
 			cache := &pb.SortingCache{}
-			entry1 := appendCache(cache, r1)
-			entry2 := appendCache(cache, r2)
+			entry1 := appendCache(cache, r1, nil, nil)
+			entry2 := appendCache(cache, r2, nil, nil)
 			if sw == 1 {
 				entry1, entry2 = entry2, entry1
 			}
 
-			val := sortByLabelCatCached(entry1, entry2, cache)
+			val := compareLabelKeys(entry1.GetLabelKey(), entry2.GetLabelKey())
 
 			if (sw == 0 && val != -1) || (sw == 1 && val != 1) {
 				t.Errorf("Test is poorly ordered: %v, %v => %v", entry1, entry2, val)
@@ -270,3 +255,66 @@ func TestLoadedSorting(t *testing.T) {
 		}
 	}
 }
+
+func benchmarkRecords(n int) ([]*pbrc.Record, map[int32]*regexp.Regexp) {
+	var records []*pbrc.Record
+	for i := 0; i < n; i++ {
+		label := int32(i % 40)
+		records = append(records, &pbrc.Record{Release: &pbd.Release{
+			InstanceId: int64(i),
+			Title:      fmt.Sprintf("Title %v", i%97),
+			Labels:     []*pbd.Label{{Id: label, Name: fmt.Sprintf("Label %v", label), Catno: fmt.Sprintf("CAT-%v-%03d", i%7, i)}},
+		}})
+	}
+	extractors := make(map[int32]string)
+	for label := int32(0); label < 40; label += 2 {
+		extractors[label] = "CAT-\\d+-(\\d+)"
+	}
+	return records, compileExtractors(extractors)
+}
+
+func TestSortByLabelMatchesComparator(t *testing.T) {
+	records, extractors := benchmarkRecords(500)
+	sorted := append([]*pbrc.Record{}, records...)
+	sortByLabel(sorted, extractors, nil)
+
+	for i := 1; i < len(sorted); i++ {
+		if sortByLabelCat(sorted[i].GetRelease(), sorted[i-1].GetRelease(), extractors, nil) < 0 {
+			t.Fatalf("Out of order at %v: %v then %v", i, sorted[i-1], sorted[i])
+		}
+	}
+}
+
+// The baseline rebuilds both keys on every comparison
+func BenchmarkSortByLabelCat(b *testing.B) {
+	records, extractors := benchmarkRecords(5000)
+	for i := 0; i < b.N; i++ {
+		sorted := append([]*pbrc.Record{}, records...)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sortByLabelCat(sorted[i].GetRelease(), sorted[j].GetRelease(), extractors, nil) < 0
+		})
+	}
+}
+
+func BenchmarkSortByLabel(b *testing.B) {
+	records, extractors := benchmarkRecords(5000)
+	for i := 0; i < b.N; i++ {
+		sorted := append([]*pbrc.Record{}, records...)
+		sortByLabel(sorted, extractors, nil)
+	}
+}
+
+func BenchmarkSortCachedByLabel(b *testing.B) {
+	records, extractors := benchmarkRecords(5000)
+	cache := &pb.SortingCache{}
+	var ids []int64
+	for _, rec := range records {
+		appendCache(cache, rec, extractors, nil)
+		ids = append(ids, rec.GetRelease().GetInstanceId())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sorted := append([]int64{}, ids...)
+		sortCachedByLabel(sorted, cache)
+	}
+}
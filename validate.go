@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// locationPath names a location in a field path, by name where it has one
+func locationPath(i int, loc *pb.Location) string {
+	if loc.GetName() == "" {
+		return fmt.Sprintf("locations[%v]", i)
+	}
+	return fmt.Sprintf("locations[%v]", loc.GetName())
+}
+
+func sortedKeys[V any](m map[int32]V) []int32 {
+	var keys []int32
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// validateOrganisation lists the problems with the locations, extractors and label rules in the org
+func validateOrganisation(org *pb.Organisation) []*pb.FieldViolation {
+	var violations []*pb.FieldViolation
+	add := func(field, format string, args ...interface{}) {
+		violations = append(violations, &pb.FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+	}
+
+	names := make(map[string]bool)
+	holders := make(map[int32]string)
+	for i, loc := range org.GetLocations() {
+		path := locationPath(i, loc)
+
+		if loc.GetName() == "" {
+			add(path+".name", "locations must be named")
+		} else if names[loc.GetName()] {
+			add(path+".name", "%v is used by more than one location", loc.GetName())
+		}
+		names[loc.GetName()] = true
+
+		if loc.GetSlots() < 0 {
+			add(path+".slots", "slots must not be negative, got %v", loc.GetSlots())
+		}
+		if loc.GetQuota().GetSlots() > 0 && numSlots(loc) <= 0 {
+			add(path+".quota", "slot quotas need the location to have slots")
+		}
+		if loc.GetQuota().GetWidth() > 0 && slotCapacity(loc, 1) <= 0 {
+			add(path+".quota", "width quotas need a slot width, from total_width or the slot definitions")
+		}
+
+		folders := make(map[int32]bool)
+		for _, folder := range loc.GetFolderIds() {
+			if folders[folder] {
+				add(path+".folder_ids", "folder %v is listed twice", folder)
+			} else if holder, ok := holders[folder]; ok {
+				add(path+".folder_ids", "folder %v is already held by %v", folder, holder)
+			} else {
+				holders[folder] = loc.GetName()
+			}
+			folders[folder] = true
+		}
+		for _, folder := range sortedKeys(loc.GetFolderOrder()) {
+			if !folders[folder] {
+				add(path+".folder_order", "folder %v is not in folder_ids", folder)
+			}
+		}
+		for _, folder := range sortedKeys(loc.GetFolderSort()) {
+			if !folders[folder] {
+				add(path+".folder_sort", "folder %v is not in folder_ids", folder)
+			}
+		}
+		for _, folder := range sortedKeys(loc.GetHardGap()) {
+			if !folders[folder] {
+				add(path+".hard_gap", "folder %v is not in folder_ids", folder)
+			}
+		}
+	}
+
+	labels := make(map[int32]bool)
+	for _, ex := range org.GetExtractors() {
+		if problem := extractorProblem(ex); problem != "" {
+			add(fmt.Sprintf("extractors[%v].extractor", ex.GetLabelId()), "%v", problem)
+		}
+		if labels[ex.GetLabelId()] {
+			add(fmt.Sprintf("extractors[%v].label_id", ex.GetLabelId()), "label %v has more than one extractor", ex.GetLabelId())
+		}
+		labels[ex.GetLabelId()] = true
+	}
+
+	aliased := make(map[int32]bool)
+	for i, alias := range org.GetLabelAliases() {
+		path := fmt.Sprintf("label_aliases[%v]", i)
+		if alias.GetLabelId() == 0 {
+			add(path+".label_id", "aliases need a label id")
+		} else if aliased[alias.GetLabelId()] {
+			add(path+".label_id", "label %v has more than one alias", alias.GetLabelId())
+		}
+		aliased[alias.GetLabelId()] = true
+		if strings.TrimSpace(alias.GetCanonicalName()) == "" && strings.TrimSpace(alias.GetFamily()) == "" {
+			add(path, "aliases need a canonical name or a family")
+		}
+	}
+
+	overridden := make(map[int64]bool)
+	for i, override := range org.GetLabelOverrides() {
+		path := fmt.Sprintf("label_overrides[%v]", i)
+		if override.GetInstanceId() == 0 {
+			add(path+".instance_id", "overrides need an instance id")
+		} else if overridden[override.GetInstanceId()] {
+			add(path+".instance_id", "record %v has more than one override", override.GetInstanceId())
+		}
+		overridden[override.GetInstanceId()] = true
+		if override.GetLabelId() == 0 && strings.TrimSpace(override.GetCatno()) == "" {
+			add(path, "overrides need a label or a catalogue number")
+		}
+	}
+
+	return violations
+}
+
+// invalidArgument wraps the violations up as an InvalidArgument error carrying a BadRequest
+func invalidArgument(violations []*pb.FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.GetField(), Description: v.GetDescription()})
+	}
+	st := status.Newf(codes.InvalidArgument, "Invalid organisation: %v %v", violations[0].GetField(), violations[0].GetDescription())
+	if dst, err := st.WithDetails(br); err == nil {
+		st = dst
+	}
+	return st.Err()
+}
+
+// checkOrganisation rejects the org if it has problems beyond those already present in before;
+// this stops a stored org with existing problems from blocking unrelated changes
+func checkOrganisation(before []*pb.FieldViolation, org *pb.Organisation) error {
+	existing := make(map[string]bool)
+	for _, v := range before {
+		existing[v.GetField()+v.GetDescription()] = true
+	}
+
+	var violations []*pb.FieldViolation
+	for _, v := range validateOrganisation(org) {
+		if !existing[v.GetField()+v.GetDescription()] {
+			violations = append(violations, v)
+		}
+	}
+	return invalidArgument(violations)
+}
+
+// ValidateOrganisation reports the problems in the stored organisation
+func (s *Server) ValidateOrganisation(ctx context.Context, _ *pb.ValidateOrganisationRequest) (*pb.ValidateOrganisationResponse, error) {
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValidateOrganisationResponse{Violations: validateOrganisation(org)}, nil
+}
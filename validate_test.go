@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestValidateOrganisation(t *testing.T) {
+	org := &pb.Organisation{
+		Locations: []*pb.Location{
+			{Name: "first", Slots: 1, FolderIds: []int32{1, 2}},
+			{Name: "first", Slots: -1, FolderIds: []int32{2}, FolderSort: map[int32]pb.Location_Sorting{3: pb.Location_BY_DATE_ADDED}},
+			{Name: "quota", Quota: &pb.Quota{QuotaType: &pb.Quota_Slots{Slots: 2}}},
+			{Name: "width", Quota: &pb.Quota{QuotaType: &pb.Quota_Width{Width: 20}}},
+			{Name: "total", Quota: &pb.Quota{TotalWidth: 10, QuotaType: &pb.Quota_Width{Width: 20}}},
+			{Name: "defined", Quota: &pb.Quota{QuotaType: &pb.Quota_Width{Width: 20}}, SlotDefinitions: []*pb.SlotDefinition{{Width: 10}}},
+		},
+	}
+
+	fields := make(map[string]bool)
+	for _, v := range validateOrganisation(org) {
+		fields[v.GetField()] = true
+	}
+	for _, field := range []string{"locations[first].name", "locations[first].slots", "locations[first].folder_ids", "locations[first].folder_sort", "locations[quota].quota", "locations[width].quota"} {
+		if !fields[field] {
+			t.Errorf("Missing violation for %v: %v", field, fields)
+		}
+	}
+	if len(fields) != 6 {
+		t.Errorf("Too many violations: %v", fields)
+	}
+}
+
+func TestUpdateLocationRejectsClaimedFolder(t *testing.T) {
+	s := getTestServer(".updateValidate")
+	ctx := context.Background()
+	_, err := s.ApplyConfig(ctx, &pb.ApplyConfigRequest{Config: &pb.OrganisationConfig{Locations: []*pb.Location{
+		{Name: "first", FolderIds: []int32{1}},
+		{Name: "second", FolderIds: []int32{2}},
+	}}})
+	if err != nil {
+		t.Fatalf("Unable to set up org: %v", err)
+	}
+
+	_, err = s.UpdateLocation(ctx, &pb.UpdateLocationRequest{Location: "second", Update: &pb.Location{FolderIds: []int32{1}}})
+	st, _ := status.FromError(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Update should have failed: %v", err)
+	}
+	if len(st.Details()) != 1 || st.Details()[0].(*errdetails.BadRequest).GetFieldViolations()[0].GetField() != "locations[second].folder_ids" {
+		t.Errorf("Bad details: %v", st.Details())
+	}
+
+	resp, err := s.ValidateOrganisation(ctx, &pb.ValidateOrganisationRequest{})
+	if err != nil || len(resp.GetViolations()) != 0 {
+		t.Errorf("Bad update was stored: %v, %v", resp, err)
+	}
+}
+
+func TestExistingProblemsDoNotBlock(t *testing.T) {
+	org := &pb.Organisation{Locations: []*pb.Location{{Name: "bad", Slots: -1}}}
+	before := validateOrganisation(org)
+	org.Locations = append(org.Locations, &pb.Location{Name: "good", Slots: 1})
+	if err := checkOrganisation(before, org); err != nil {
+		t.Errorf("Existing problem blocked change: %v", err)
+	}
+}
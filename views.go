@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+// placementView builds a placement row from the cached record details
+func placementView(loc *pb.Location, rp *pb.ReleasePlacement, entry *pb.CacheEntry) *pb.PlacementView {
+	view := &pb.PlacementView{
+		Location:  loc.GetName(),
+		Placement: rp,
+		Folder:    entry.GetFolder(),
+		Category:  entry.GetCategory(),
+		Label:     entry.GetMainLabel(),
+		SizeClass: entry.GetSizeClass(),
+		Width:     entry.GetWidth(),
+		Filed:     entry.GetFilled(),
+		Artist:    entry.GetArtist(),
+		Catno:     entry.GetCatno(),
+		Format:    entry.GetFormat(),
+		ReleaseId: entry.GetReleaseId(),
+	}
+	if entry != nil {
+		view.Sleeve = pbrc.ReleaseMetadata_SleeveState(entry.GetSleeve()).String()
+	}
+	if rp.GetSizeClass() != "" {
+		view.SizeClass = rp.GetSizeClass()
+	}
+	return view
+}
+
+func cacheIndex(cache *pb.SortingCache) map[int64]*pb.CacheEntry {
+	entries := make(map[int64]*pb.CacheEntry)
+	for _, entry := range cache.GetCache() {
+		entries[entry.GetInstanceId()] = entry
+	}
+	return entries
+}
+
+// locationViews builds the rows for every placement in the location
+func locationViews(loc *pb.Location, entries map[int64]*pb.CacheEntry) []*pb.PlacementView {
+	var views []*pb.PlacementView
+	for _, rp := range loc.GetReleasesLocation() {
+		views = append(views, placementView(loc, rp, entries[rp.GetInstanceId()]))
+	}
+	return views
+}
+
+// wantsViews reports whether the request filters, pages or asks for the placement rows
+func wantsViews(req *pb.GetOrganisationRequest) bool {
+	return req.GetPlacementsOnly() || req.GetPageSize() > 0 || req.GetPageToken() != "" ||
+		req.GetMinSlot() != 0 || req.GetMaxSlot() != 0 || req.GetFolder() != 0 ||
+		req.GetCategory() != "" || req.GetSizeClass() != "" || req.GetTitle() != ""
+}
+
+func matchesView(req *pb.GetOrganisationRequest, view *pb.PlacementView) bool {
+	slot := view.GetPlacement().GetSlot()
+	return (req.GetMinSlot() == 0 || slot >= req.GetMinSlot()) &&
+		(req.GetMaxSlot() == 0 || slot <= req.GetMaxSlot()) &&
+		(req.GetFolder() == 0 || view.GetFolder() == req.GetFolder()) &&
+		(req.GetCategory() == "" || strings.EqualFold(view.GetCategory(), req.GetCategory())) &&
+		(req.GetSizeClass() == "" || view.GetSizeClass() == req.GetSizeClass()) &&
+		(req.GetTitle() == "" || strings.Contains(strings.ToLower(view.GetPlacement().GetTitle()), strings.ToLower(req.GetTitle())))
+}
+
+// buildViews returns the page of placement rows matching the request along with the token for the next page
+func buildViews(req *pb.GetOrganisationRequest, locations []*pb.Location, cache *pb.SortingCache) ([]*pb.PlacementView, string, error) {
+	start := 0
+	if req.GetPageToken() != "" {
+		val, err := strconv.Atoi(req.GetPageToken())
+		if err != nil || val < 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "Bad page token: %v", req.GetPageToken())
+		}
+		start = val
+	}
+
+	entries := cacheIndex(cache)
+
+	var views []*pb.PlacementView
+	for _, loc := range locations {
+		for _, view := range locationViews(loc, entries) {
+			if matchesView(req, view) {
+				views = append(views, view)
+			}
+		}
+	}
+
+	if start >= len(views) {
+		return []*pb.PlacementView{}, "", nil
+	}
+	views = views[start:]
+	if req.GetPageSize() > 0 && int(req.GetPageSize()) < len(views) {
+		return views[:req.GetPageSize()], strconv.Itoa(start + int(req.GetPageSize())), nil
+	}
+	return views, "", nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestBuildViews(t *testing.T) {
+	cache := &pb.SortingCache{Cache: []*pb.CacheEntry{
+		{InstanceId: 1, Folder: 10, Category: "IN_COLLECTION", MainLabel: "Warp", SizeClass: "12"},
+		{InstanceId: 2, Folder: 20, Category: "IN_COLLECTION", SizeClass: "7"},
+		{InstanceId: 3, Folder: 10, Category: "PREPARE_TO_SELL", SizeClass: "12"},
+	}}
+	locations := []*pb.Location{{Name: "test", ReleasesLocation: []*pb.ReleasePlacement{
+		{InstanceId: 1, Slot: 1, Title: "Selected Ambient Works"},
+		{InstanceId: 2, Slot: 1, Title: "Windowlicker"},
+		{InstanceId: 3, Slot: 2, Title: "Drukqs"},
+	}}}
+
+	tests := []struct {
+		req *pb.GetOrganisationRequest
+		ids []int64
+	}{
+		{&pb.GetOrganisationRequest{}, []int64{1, 2, 3}},
+		{&pb.GetOrganisationRequest{MinSlot: 2}, []int64{3}},
+		{&pb.GetOrganisationRequest{Folder: 10}, []int64{1, 3}},
+		{&pb.GetOrganisationRequest{Category: "prepare_to_sell"}, []int64{3}},
+		{&pb.GetOrganisationRequest{SizeClass: "7"}, []int64{2}},
+		{&pb.GetOrganisationRequest{Title: "works"}, []int64{1}},
+	}
+
+	for _, tt := range tests {
+		views, _, err := buildViews(tt.req, locations, cache)
+		if err != nil {
+			t.Fatalf("Unable to build views: %v", err)
+		}
+		if len(views) != len(tt.ids) {
+			t.Errorf("Bad views for %v: %v", tt.req, views)
+			continue
+		}
+		for i, view := range views {
+			if view.GetPlacement().GetInstanceId() != tt.ids[i] {
+				t.Errorf("Bad view for %v: %v", tt.req, views)
+			}
+		}
+	}
+
+	if views, _, _ := buildViews(&pb.GetOrganisationRequest{}, locations, cache); views[0].GetLabel() != "Warp" || views[0].GetLocation() != "test" {
+		t.Errorf("View was not filled from the cache: %v", views[0])
+	}
+}
+
+func TestBuildViewsPaging(t *testing.T) {
+	locations := []*pb.Location{{Name: "test", ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1}, {InstanceId: 2}, {InstanceId: 3}}}}
+
+	views, next, err := buildViews(&pb.GetOrganisationRequest{PageSize: 2}, locations, &pb.SortingCache{})
+	if err != nil || len(views) != 2 || next == "" {
+		t.Fatalf("Bad first page: %v, %v, %v", views, next, err)
+	}
+
+	views, next, err = buildViews(&pb.GetOrganisationRequest{PageSize: 2, PageToken: next}, locations, &pb.SortingCache{})
+	if err != nil || len(views) != 1 || views[0].GetPlacement().GetInstanceId() != 3 || next != "" {
+		t.Errorf("Bad second page: %v, %v, %v", views, next, err)
+	}
+
+	if _, _, err := buildViews(&pb.GetOrganisationRequest{PageToken: "bad"}, locations, &pb.SortingCache{}); err == nil {
+		t.Errorf("Bad page token was accepted")
+	}
+}
+
+func TestViewCarriesDisplayDetails(t *testing.T) {
+	entry := buildCacheEntry(&pbrc.Record{
+		Release: &pbd.Release{
+			Id:         55,
+			InstanceId: 1,
+			Artists:    []*pbd.Artist{{Name: "Aphex Twin"}},
+			Labels:     []*pbd.Label{{Name: "Warp", Catno: "WARP 123"}},
+			Formats:    []*pbd.Format{{Name: "Vinyl", Descriptions: []string{"LP", "Album"}}},
+		},
+		Metadata: &pbrc.ReleaseMetadata{Sleeve: pbrc.ReleaseMetadata_VINYL_STORAGE_NO_INNER},
+	}, nil, nil)
+
+	view := placementView(&pb.Location{Name: "test"}, &pb.ReleasePlacement{InstanceId: 1}, entry)
+	if view.GetArtist() != "Aphex Twin" || view.GetLabel() != "Warp" || view.GetCatno() != "WARP 123" ||
+		view.GetFormat() != "Vinyl, LP, Album" || view.GetSleeve() != "VINYL_STORAGE_NO_INNER" || view.GetReleaseId() != 55 {
+		t.Errorf("Bad view: %v", view)
+	}
+}
+
+func TestGetOrganisationRows(t *testing.T) {
+	s := getTestServer(".getOrganisationRows")
+	ctx := context.Background()
+	org, err := s.readOrg(ctx)
+	if err != nil {
+		t.Fatalf("Unable to read org: %v", err)
+	}
+	org.Locations = append(org.Locations, &pb.Location{
+		Name:             "rows",
+		ReleasesLocation: []*pb.ReleasePlacement{{InstanceId: 1, Slot: 1}, {InstanceId: 2, Slot: 1}, {InstanceId: 3, Slot: 2}},
+	})
+	if err := s.saveOrg(ctx, org); err != nil {
+		t.Fatalf("Unable to save org: %v", err)
+	}
+	req := &pb.GetOrganisationRequest{Locations: []*pb.Location{{Name: "rows"}}}
+
+	// Unfiltered callers get the placements in the locations and no rows
+	resp, err := s.GetOrganisation(ctx, req)
+	if err != nil || len(resp.GetLocations()) != 1 || len(resp.GetLocations()[0].GetReleasesLocation()) != 3 || len(resp.GetPlacements()) != 0 {
+		t.Fatalf("Bad unfiltered response: %v, %v", resp, err)
+	}
+
+	req.PageSize = 2
+	resp, err = s.GetOrganisation(ctx, req)
+	if err != nil || len(resp.GetLocations()) != 1 || len(resp.GetLocations()[0].GetReleasesLocation()) != 0 || len(resp.GetPlacements()) != 2 {
+		t.Fatalf("Bad first page: %v, %v", resp, err)
+	}
+
+	req.PageToken = resp.GetNextPageToken()
+	resp, err = s.GetOrganisation(ctx, req)
+	if err != nil || len(resp.GetLocations()) != 0 || len(resp.GetPlacements()) != 1 {
+		t.Errorf("Bad second page: %v, %v", resp, err)
+	}
+
+	resp, err = s.GetOrganisation(ctx, &pb.GetOrganisationRequest{Locations: req.GetLocations(), MinSlot: 2})
+	if err != nil || len(resp.GetLocations()[0].GetReleasesLocation()) != 0 || len(resp.GetPlacements()) != 1 {
+		t.Errorf("Bad filtered response: %v, %v", resp, err)
+	}
+}
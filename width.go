@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+const (
+	// The number of measured records we need in a group before trusting it
+	minWidthSamples = 3
+)
+
+// widthEstimator predicts the spine width of unmeasured records from
+// the records in the cache which have been measured
+type widthEstimator struct {
+	groups  map[string][]float64
+	median  float64
+	factors map[int32]float32
+	rules   *labelRules
+}
+
+func isGatefold(rec *pbrc.Record) bool {
+	if rec.GetRelease().GetGatefold() {
+		return true
+	}
+	for _, format := range rec.GetRelease().GetFormats() {
+		if strings.Contains(format.GetText(), "Gatefold") {
+			return true
+		}
+		for _, desc := range format.GetDescriptions() {
+			if strings.Contains(desc, "Gatefold") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isBoxset(rec *pbrc.Record) bool {
+	if rec.GetRelease().GetBoxset() {
+		return true
+	}
+	for _, format := range rec.GetRelease().GetFormats() {
+		if strings.Contains(format.GetText(), "Box") || strings.Contains(format.GetName(), "Box") {
+			return true
+		}
+	}
+	return false
+}
+
+// widthGroups returns the groups a record falls into, most specific first
+func widthGroups(entry *pb.CacheEntry) []string {
+	lps := entry.GetFormatQuantity()
+	if lps <= 0 {
+		lps = 1
+	}
+
+	var groups []string
+	if entry.GetLabelId() > 0 {
+		groups = append(groups, fmt.Sprintf("label:%v|lp:%v", entry.GetLabelId(), lps))
+	}
+	return append(groups,
+		fmt.Sprintf("lp:%v|gf:%v|box:%v|sleeve:%v", lps, entry.GetGatefold(), entry.GetBoxset(), entry.GetSleeve()),
+		fmt.Sprintf("lp:%v|gf:%v|box:%v", lps, entry.GetGatefold(), entry.GetBoxset()),
+		fmt.Sprintf("lp:%v", lps))
+}
+
+// groupConfidence is how far we trust each level of widthGroups
+var groupConfidence = []float32{0.9, 0.8, 0.7, 0.5}
+
+func newWidthEstimator(cache *pb.SortingCache, factors map[int32]float32, rules *labelRules) *widthEstimator {
+	est := &widthEstimator{groups: make(map[string][]float64), factors: factors, rules: rules}
+
+	var all []float64
+	for _, entry := range cache.GetCache() {
+		if entry.GetWidth() > 0 {
+			all = append(all, entry.GetWidth())
+			for _, group := range widthGroups(entry) {
+				est.groups[group] = append(est.groups[group], entry.GetWidth())
+			}
+		}
+	}
+
+	for _, widths := range est.groups {
+		sort.Float64s(widths)
+	}
+	// Fall back to a nominal width when nothing has been measured
+	est.median = 1
+	if len(all) > 0 {
+		sort.Float64s(all)
+		est.median = all[len(all)/2]
+	}
+
+	return est
+}
+
+// estimate predicts the spine width of a record along with our confidence in it
+func (w *widthEstimator) estimate(r *pbrc.Record) (float32, float32) {
+	if r.GetMetadata().GetRecordWidth() > 0 {
+		return r.GetMetadata().GetRecordWidth(), 1
+	}
+
+	if w == nil {
+		return 0, 0
+	}
+
+	entry := &pb.CacheEntry{
+		FormatQuantity: r.GetRelease().GetFormatQuantity(),
+		Gatefold:       isGatefold(r),
+		Boxset:         isBoxset(r),
+		LabelId:        w.rules.mainLabel(r.GetRelease()).GetId(),
+		Sleeve:         int32(r.GetMetadata().GetSleeve()),
+	}
+	groups := widthGroups(entry)
+	offset := len(groupConfidence) - len(groups)
+	for i, group := range groups {
+		if widths := w.groups[group]; len(widths) >= minWidthSamples {
+			n := float32(len(widths))
+			return float32(widths[len(widths)/2]), groupConfidence[i+offset] * n / (n + 2)
+		}
+	}
+
+	return float32(w.median), 0.1
+}
+
+// width returns the width a record takes up on the shelf
+func (w *widthEstimator) width(r *pbrc.Record) float32 {
+	width, _ := w.estimate(r)
+	return sleeveAdjust(width, r.GetMetadata().GetSleeve(), w.getFactors())
+}
+
+func (w *widthEstimator) getFactors() map[int32]float32 {
+	if w == nil {
+		return defaultSleeveFactors()
+	}
+	return w.factors
+}
+
+// estimateTotalWidth sums the spine widths of the records, estimating where they are missing
+func estimateTotalWidth(est *widthEstimator, records []*pbrc.Record) (float32, bool, float32) {
+	total := float32(0)
+	estimated := false
+	confidence := float32(1)
+	for _, r := range records {
+		width, conf := est.estimate(r)
+		total += width
+		if r.GetMetadata().GetRecordWidth() <= 0 {
+			estimated = true
+			if conf < confidence {
+				confidence = conf
+			}
+		}
+	}
+	return total, estimated, confidence
+}
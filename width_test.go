@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+
+	pbd "github.com/brotherlogic/godiscogs/proto"
+	pbrc "github.com/brotherlogic/recordcollection/proto"
+	pb "github.com/brotherlogic/recordsorganiser/proto"
+)
+
+func TestEstimateWidth(t *testing.T) {
+	cache := &pb.SortingCache{}
+	for i := int64(1); i <= 3; i++ {
+		appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: i, FormatQuantity: 1, Labels: []*pbd.Label{{Name: "Warp", Id: 10}}}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 2}}, nil, nil)
+		appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: i + 10, FormatQuantity: 3, Formats: []*pbd.Format{{Text: "Box Set"}}}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 10}}, nil, nil)
+	}
+	est := newWidthEstimator(cache, defaultSleeveFactors(), nil)
+
+	width, conf := est.estimate(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Labels: []*pbd.Label{{Name: "Warp", Id: 10}}}})
+	if width != 2 || conf <= 0 || conf >= 1 {
+		t.Errorf("Bad label estimate: %v, %v", width, conf)
+	}
+
+	width, _ = est.estimate(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 3, Boxset: true}})
+	if width != 10 {
+		t.Errorf("Bad box set estimate: %v", width)
+	}
+
+	width, conf = est.estimate(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 2}})
+	if width != 10 || conf > 0.1 {
+		t.Errorf("Unknown format should use the median: %v, %v", width, conf)
+	}
+
+	width, conf = est.estimate(&pbrc.Record{Metadata: &pbrc.ReleaseMetadata{RecordWidth: 4}})
+	if width != 4 || conf != 1 {
+		t.Errorf("Measured width should be used: %v, %v", width, conf)
+	}
+}
+
+func TestEstimateTotalWidth(t *testing.T) {
+	est := newWidthEstimator(&pb.SortingCache{}, defaultSleeveFactors(), nil)
+	total, estimated, _ := estimateTotalWidth(est, []*pbrc.Record{
+		{Release: &pbd.Release{}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 3}},
+		{Release: &pbd.Release{}, Metadata: &pbrc.ReleaseMetadata{}},
+	})
+
+	if total != 4 || !estimated {
+		t.Errorf("Bad total: %v, %v", total, estimated)
+	}
+}
+
+// fallbackWidth is the width given to a record the cache has nothing similar to
+func fallbackWidth(rec *pbrc.Record) float32 {
+	cache := &pb.SortingCache{}
+	appendCache(cache, &pbrc.Record{Release: &pbd.Release{InstanceId: 1, FormatQuantity: 9}, Metadata: &pbrc.ReleaseMetadata{RecordWidth: 2}}, nil, nil)
+	return newWidthEstimator(cache, defaultSleeveFactors(), nil).width(rec)
+}
+
+func TestGetFormatWidth(t *testing.T) {
+	v := fallbackWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Labels: []*pbd.Label{&pbd.Label{Name: "Death Waltz Recording Company"}}}})
+	if v != sleeveAdjust(2, pbrc.ReleaseMetadata_SLEEVE_UNKNOWN, defaultSleeveFactors()) {
+		t.Errorf("Bad width: %v", v)
+	}
+}
+
+func TestGetFormatWidthForNowAgain(t *testing.T) {
+	v := fallbackWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Labels: []*pbd.Label{&pbd.Label{Name: "Now-Again Records"}}}})
+	if v != sleeveAdjust(2, pbrc.ReleaseMetadata_SLEEVE_UNKNOWN, defaultSleeveFactors()) {
+		t.Errorf("Bad width: %v", v)
+	}
+}
+
+func TestGetFormatWidthForBox(t *testing.T) {
+	v := fallbackWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 4, Formats: []*pbd.Format{&pbd.Format{Text: "Boxset"}}}})
+	if v != sleeveAdjust(2, pbrc.ReleaseMetadata_SLEEVE_UNKNOWN, defaultSleeveFactors()) {
+		t.Errorf("Bad width: %v", v)
+	}
+}
+
+func TestGetFormatWidthForGatefold(t *testing.T) {
+	v := fallbackWidth(&pbrc.Record{Release: &pbd.Release{FormatQuantity: 1, Formats: []*pbd.Format{&pbd.Format{Text: "Gatefold"}}}})
+	if v != sleeveAdjust(2, pbrc.ReleaseMetadata_SLEEVE_UNKNOWN, defaultSleeveFactors()) {
+		t.Errorf("Bad width: %v", v)
+	}
+}